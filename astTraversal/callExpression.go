@@ -87,6 +87,12 @@ func (c *CallExpressionTraverser) Type() (*types.Func, error) {
 }
 
 func (c *CallExpressionTraverser) ReturnType(returnNum int) (types.Type, error) {
+	// Calls with explicit type arguments (i.e. NewPage[Post](...)) are to an instantiated generic function
+	switch c.Node.Fun.(type) {
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return c.instantiatedReturnType(returnNum)
+	}
+
 	funcType, err := c.Type()
 	if err != nil {
 		return nil, err
@@ -97,6 +103,11 @@ func (c *CallExpressionTraverser) ReturnType(returnNum int) (types.Type, error)
 		return nil, ErrInvalidNodeType
 	}
 
+	// The return types of a generic function depend on the type arguments it is called with
+	if signature.TypeParams().Len() > 0 {
+		return c.instantiatedReturnType(returnNum)
+	}
+
 	if signature.Results().Len() <= returnNum {
 		return nil, ErrInvalidIndex
 	}
@@ -104,6 +115,28 @@ func (c *CallExpressionTraverser) ReturnType(returnNum int) (types.Type, error)
 	return signature.Results().At(returnNum).Type(), nil
 }
 
+// instantiatedReturnType finds the return type of a call to a generic function from the type checker, where the type arguments (whether explicit or inferred) have been substituted.
+func (c *CallExpressionTraverser) instantiatedReturnType(returnNum int) (types.Type, error) {
+	callType, err := c.File.Package.FindTypeForExpr(c.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	if tuple, ok := callType.(*types.Tuple); ok {
+		if tuple.Len() <= returnNum {
+			return nil, ErrInvalidIndex
+		}
+
+		return tuple.At(returnNum).Type(), nil
+	}
+
+	if returnNum > 0 {
+		return nil, ErrInvalidIndex
+	}
+
+	return callType, nil
+}
+
 func (c *CallExpressionTraverser) ArgType(argNum int) (types.Object, error) {
 	funcType, err := c.Type()
 	if err != nil {
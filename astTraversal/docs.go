@@ -2,6 +2,67 @@ package astTraversal
 
 import "strings"
 
+const (
+	// DeprecatedDocPrefix starts the paragraph of a doc comment that deprecates what it documents (the Go convention).
+	DeprecatedDocPrefix = "Deprecated:"
+	// ExampleDocPrefix starts a line of a doc comment with an example value.
+	ExampleDocPrefix = "Example:"
+	// DefaultDocPrefix starts a line of a doc comment with the default value.
+	DefaultDocPrefix = "Default:"
+)
+
+// DocMetadata is the metadata taken from the conventions in a doc comment.
+type DocMetadata struct {
+	// Deprecated is true if a paragraph of the doc comment starts with "Deprecated:"
+	Deprecated bool
+	// Examples are the values of the lines starting with "Example:"
+	Examples []string
+	// Default is the value of the line starting with "Default:"
+	Default string
+}
+
 func FormatDoc(doc string) string {
 	return strings.TrimSpace(strings.TrimPrefix(doc, "//"))
 }
+
+// ParseDoc takes the metadata from the conventions in a doc comment, returning the doc comment without the example and default lines.
+// The deprecation paragraph is kept, as it usually explains what to use instead.
+// The prefixes aren't case-sensitive (i.e. "default:" is the same as "Default:").
+func ParseDoc(doc string) (string, DocMetadata) {
+	var metadata DocMetadata
+
+	lines := strings.Split(doc, "\n")
+	docLines := make([]string, 0, len(lines))
+	startOfParagraph := true
+	for _, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+
+		if value, ok := cutDocPrefix(trimmedLine, ExampleDocPrefix); ok {
+			metadata.Examples = append(metadata.Examples, value)
+			continue
+		}
+
+		if value, ok := cutDocPrefix(trimmedLine, DefaultDocPrefix); ok {
+			metadata.Default = value
+			continue
+		}
+
+		if _, ok := cutDocPrefix(trimmedLine, DeprecatedDocPrefix); ok && startOfParagraph {
+			metadata.Deprecated = true
+		}
+
+		startOfParagraph = trimmedLine == ""
+		docLines = append(docLines, line)
+	}
+
+	return strings.TrimSpace(strings.Join(docLines, "\n")), metadata
+}
+
+// cutDocPrefix returns the rest of the line after the prefix, if the line starts with it.
+func cutDocPrefix(line string, prefix string) (string, bool) {
+	if len(line) < len(prefix) || !strings.EqualFold(line[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(line[len(prefix):]), true
+}
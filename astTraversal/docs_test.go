@@ -0,0 +1,49 @@
+package astTraversal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDoc(t *testing.T) {
+	assert.Equal(t, "Pet is a pet.", FormatDoc("// Pet is a pet.\n"))
+}
+
+func TestParseDoc(t *testing.T) {
+	t.Run("without metadata", func(t *testing.T) {
+		doc, metadata := ParseDoc("Name is the name of the pet.\nIt must be unique.")
+
+		assert.Equal(t, "Name is the name of the pet.\nIt must be unique.", doc)
+		assert.Equal(t, DocMetadata{}, metadata)
+	})
+
+	t.Run("examples and default", func(t *testing.T) {
+		doc, metadata := ParseDoc("Age is the age of the pet.\nExample: 3\nexample: 5\ndefault: 1")
+
+		assert.Equal(t, "Age is the age of the pet.", doc)
+		assert.Equal(t, DocMetadata{
+			Examples: []string{"3", "5"},
+			Default:  "1",
+		}, metadata)
+	})
+
+	t.Run("deprecated paragraph", func(t *testing.T) {
+		doc, metadata := ParseDoc("Tag is the tag of the pet.\n\nDeprecated: use Tags instead.")
+
+		assert.Equal(t, "Tag is the tag of the pet.\n\nDeprecated: use Tags instead.", doc)
+		assert.True(t, metadata.Deprecated)
+	})
+
+	t.Run("deprecated in the middle of a paragraph", func(t *testing.T) {
+		_, metadata := ParseDoc("Tag is the tag of the pet.\nDeprecated: it isn't.")
+
+		assert.False(t, metadata.Deprecated)
+	})
+
+	t.Run("deprecated on the first line", func(t *testing.T) {
+		_, metadata := ParseDoc("Deprecated: use getPets instead.")
+
+		assert.True(t, metadata.Deprecated)
+	})
+}
@@ -0,0 +1,231 @@
+package astTraversal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+)
+
+// enumValues finds the values of the constants of a named basic type, which are declared in the package of the type, in the order they are declared.
+// Values that are declared more than once (i.e. an alias for a default value) are only included once.
+// For types that aren't strings, the names of the values are found too, from the String method of the type if it maps the values to names, or the names of the constants otherwise.
+func (t *TypeTraverser) enumValues(basic *types.Basic) ([]any, []string, error) {
+	_, err := t.Traverser.Packages.Get(t.Package)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typeName, ok := t.Package.Package.Types.Scope().Lookup(t.name).(*types.TypeName)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	scope := t.Package.Package.Types.Scope()
+	consts := make([]*types.Const, 0)
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && types.Identical(c.Type(), typeName.Type()) {
+			consts = append(consts, c)
+		}
+	}
+
+	if len(consts) == 0 {
+		return nil, nil, nil
+	}
+
+	slices.SortFunc(consts, func(a, b *types.Const) int {
+		return int(a.Pos() - b.Pos())
+	})
+
+	var stringNames map[string]string
+	isString := basic.Info()&types.IsString != 0
+	if !isString {
+		stringNames = t.enumStringNames(typeName.Type())
+	}
+
+	values := make([]any, 0, len(consts))
+	names := make([]string, 0, len(consts))
+	seen := make(map[string]struct{})
+	for _, c := range consts {
+		if _, ok := seen[c.Val().ExactString()]; ok {
+			continue
+		}
+
+		value, ok := constantToValue(basic, c.Val())
+		if !ok {
+			continue
+		}
+		seen[c.Val().ExactString()] = struct{}{}
+
+		values = append(values, value)
+
+		if !isString {
+			name, ok := stringNames[c.Val().ExactString()]
+			if !ok {
+				name = c.Name()
+			}
+
+			names = append(names, name)
+		}
+	}
+
+	if isString {
+		return values, nil, nil
+	}
+
+	return values, names, nil
+}
+
+// constantToValue converts a constant value to the Go type that represents it in the output, based on the kind of the basic type.
+func constantToValue(basic *types.Basic, value constant.Value) (any, bool) {
+	switch basic.Kind() {
+	case types.String:
+		if value.Kind() != constant.String {
+			return nil, false
+		}
+
+		return constant.StringVal(value), true
+	case types.Int:
+		i, ok := constant.Int64Val(value)
+		return int(i), ok
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		return constant.Int64Val(value)
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		return constant.Uint64Val(value)
+	case types.Float32, types.Float64:
+		f, _ := constant.Float64Val(value)
+		return f, value.Kind() == constant.Float || value.Kind() == constant.Int
+	case types.Bool:
+		if value.Kind() != constant.Bool {
+			return nil, false
+		}
+
+		return constant.BoolVal(value), true
+	}
+
+	return nil, false
+}
+
+// enumStringNames finds the names that the String method of an enum type maps its values to, by the exact string of each value.
+// The String method can use a switch statement on the value, a map literal from the values to their names, or an array or slice literal of the names indexed by the values (either in the method or a package level variable).
+// Values whose names can't be found from the String method (i.e. if it's generated by stringer) aren't in the map.
+func (t *TypeTraverser) enumStringNames(enumType types.Type) map[string]string {
+	names := make(map[string]string)
+
+	funcDecl, err := t.Package.FindMethodDecl(enumType, "String")
+	if err != nil {
+		return names
+	}
+
+	typesInfo := t.Package.Package.TypesInfo
+	stringValue := func(expr ast.Expr) (string, bool) {
+		value := typesInfo.Types[expr].Value
+		if value == nil || value.Kind() != constant.String {
+			return "", false
+		}
+
+		return constant.StringVal(value), true
+	}
+
+	// The names are often declared in a package level variable (i.e. var statusNames = map[Status]string{...}), so the variables used by the method are inspected too
+	packageVars := make(map[*types.Var]ast.Expr)
+	for _, file := range t.Package.Package.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec, ok := spec.(*ast.ValueSpec)
+				if !ok || len(valueSpec.Names) != len(valueSpec.Values) {
+					continue
+				}
+
+				for i, name := range valueSpec.Names {
+					if v, ok := typesInfo.Defs[name].(*types.Var); ok {
+						packageVars[v] = valueSpec.Values[i]
+					}
+				}
+			}
+		}
+	}
+
+	var inspect func(node ast.Node) bool
+	inspect = func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Ident:
+			if v, ok := typesInfo.Uses[n].(*types.Var); ok {
+				if value, ok := packageVars[v]; ok {
+					// Each variable is only inspected once
+					delete(packageVars, v)
+					ast.Inspect(value, inspect)
+				}
+			}
+		case *ast.CaseClause:
+			// case StatusActive: return "active"
+			for _, stmt := range n.Body {
+				returnStmt, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(returnStmt.Results) != 1 {
+					continue
+				}
+
+				name, ok := stringValue(returnStmt.Results[0])
+				if !ok {
+					break
+				}
+
+				for _, expr := range n.List {
+					if value := typesInfo.Types[expr].Value; value != nil {
+						names[value.ExactString()] = name
+					}
+				}
+				break
+			}
+		case *ast.CompositeLit:
+			switch literalType := typesInfo.TypeOf(n).Underlying().(type) {
+			case *types.Map:
+				// map[Status]string{StatusActive: "active"}
+				if !types.Identical(literalType.Key(), enumType) {
+					return true
+				}
+
+				for _, elt := range n.Elts {
+					keyValue, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+
+					name, ok := stringValue(keyValue.Value)
+					if value := typesInfo.Types[keyValue.Key].Value; ok && value != nil {
+						names[value.ExactString()] = name
+					}
+				}
+			case *types.Array, *types.Slice:
+				// [...]string{"active", "inactive"}[s]
+				index := int64(0)
+				for _, elt := range n.Elts {
+					valueExpr := elt
+					if keyValue, ok := elt.(*ast.KeyValueExpr); ok {
+						if key := typesInfo.Types[keyValue.Key].Value; key != nil {
+							index, _ = constant.Int64Val(constant.ToInt(key))
+						}
+						valueExpr = keyValue.Value
+					}
+
+					if name, ok := stringValue(valueExpr); ok {
+						names[constant.MakeInt64(index).ExactString()] = name
+					}
+					index++
+				}
+			}
+		}
+
+		return true
+	}
+
+	ast.Inspect(funcDecl.Body, inspect)
+
+	return names
+}
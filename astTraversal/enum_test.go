@@ -0,0 +1,67 @@
+package astTraversal
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeTraverser_EnumValues(t *testing.T) {
+	baseTraverser, err := CreateTraverserFromTestFile("usefulTypes.go")
+	assert.NoError(t, err)
+
+	logger := zerolog.Nop()
+	baseTraverser.SetLog(&logger)
+
+	components := make(map[string]Result)
+	baseTraverser.SetAddComponentFunction(func(result Result) error {
+		components[result.Name] = result
+		return nil
+	})
+
+	// The enums are declared in another package to the one being traversed
+	pkg := baseTraverser.Packages.FindOrAdd("github.com/ls6-events/astra/astTraversal/testfiles/otherpkg1")
+	_, err = baseTraverser.Packages.Get(pkg)
+	assert.NoError(t, err)
+
+	enum := func(t *testing.T, name string) Result {
+		obj, err := pkg.FindObjectForName(name)
+		assert.NoError(t, err)
+
+		_, err = baseTraverser.Type(obj.Type(), pkg).Result()
+		assert.NoError(t, err)
+
+		return components[name]
+	}
+
+	t.Run("String enum", func(t *testing.T) {
+		colour := enum(t, "Colour")
+		assert.Equal(t, []any{"red", "green"}, colour.EnumValues)
+		assert.Nil(t, colour.EnumNames)
+	})
+
+	t.Run("Iota enum with a switch in its String method", func(t *testing.T) {
+		priority := enum(t, "Priority")
+		assert.Equal(t, []any{0, 1, 2}, priority.EnumValues)
+		assert.Equal(t, []string{"low", "medium", "high"}, priority.EnumNames)
+	})
+
+	t.Run("Iota enum with a map in its String method", func(t *testing.T) {
+		level := enum(t, "Level")
+		assert.Equal(t, []any{1, 2}, level.EnumValues)
+		assert.Equal(t, []string{"debug", "info"}, level.EnumNames)
+	})
+
+	t.Run("Iota enum with an array in its String method", func(t *testing.T) {
+		size := enum(t, "Size")
+		assert.Equal(t, []any{uint64(0), uint64(1)}, size.EnumValues)
+		assert.Equal(t, []string{"small", "large"}, size.EnumNames)
+	})
+
+	t.Run("Iota enum without a String method", func(t *testing.T) {
+		weekday := enum(t, "Weekday")
+		assert.Equal(t, []any{0, 1}, weekday.EnumValues)
+		assert.Equal(t, []string{"Monday", "Tuesday"}, weekday.EnumNames)
+	})
+}
@@ -154,6 +154,9 @@ func (e *ExpressionTraverser) Type() (types.Type, error) {
 		return e.File.Package.FindTypeForExpr(n)
 	case *ast.MapType:
 		return e.File.Package.FindTypeForExpr(n)
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		// An instantiated generic type (i.e. Page[Post]), which is only known to the type checker
+		return e.File.Package.FindTypeForExpr(n)
 	case *ast.CompositeLit:
 		return e.Traverser.Expression(n.Type).Type()
 	case *ast.BasicLit:
@@ -0,0 +1,215 @@
+package astTraversal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// DiscriminatorTag is the struct tag that sets the value of the discriminator property for an implementation of an interface (i.e. `json:"type" discriminator:"user.created"`).
+const DiscriminatorTag = "discriminator"
+
+// Interfaces configures how the concrete types that implement named interfaces are found, so an interface can be described as one of its implementations rather than any type.
+// The interfaces and types are referred to by their full name (i.e. github.com/org/events.Event).
+type Interfaces struct {
+	// Implementations are the concrete types registered as the implementations of each interface
+	Implementations map[string][]string `json:"implementations,omitempty" yaml:"implementations,omitempty"`
+	// Discover finds the implementations of the interfaces that aren't registered, from the packages in the main module
+	Discover bool `json:"discover,omitempty" yaml:"discover,omitempty"`
+	// Discriminators are the discriminators of each interface, where the value for each implementation is the constant returned by a method
+	Discriminators map[string]InterfaceDiscriminator `json:"discriminators,omitempty" yaml:"discriminators,omitempty"`
+}
+
+// InterfaceDiscriminator is the property that tells the implementations of an interface apart, with the method of each implementation that returns its value as a constant.
+type InterfaceDiscriminator struct {
+	Property string `json:"property" yaml:"property"`
+	Method   string `json:"method" yaml:"method"`
+}
+
+// SetInterfaces sets how the implementations of named interfaces are found.
+func (t *BaseTraverser) SetInterfaces(interfaces Interfaces) *BaseTraverser {
+	t.interfaces = interfaces
+	return t
+}
+
+// implementations finds the implementations of the named interface (registered or discovered) and traverses them, so they are added as components.
+// The discriminator property is the one configured for the interface, or the first field of the implementations with a discriminator tag.
+func (t *TypeTraverser) implementations(named *types.Named, pkg *PackageNode) ([]Result, string, error) {
+	fullName := pkg.Path() + "." + named.Obj().Name()
+
+	implementationNames, ok := t.Traverser.interfaces.Implementations[fullName]
+	if !ok && t.Traverser.interfaces.Discover {
+		var err error
+		implementationNames, err = t.discoverImplementations(named, pkg)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if len(implementationNames) == 0 {
+		return nil, "", nil
+	}
+
+	discriminator, hasDiscriminatorMethod := t.Traverser.interfaces.Discriminators[fullName]
+	property := discriminator.Property
+
+	implementations := make([]Result, 0, len(implementationNames))
+	for _, implementationName := range implementationNames {
+		lastDot := strings.LastIndex(implementationName, ".")
+		if lastDot == -1 {
+			t.Traverser.Log.Warn().Str("interface", fullName).Str("implementation", implementationName).Msg("Implementation must be the full name of the type, it will be skipped")
+			continue
+		}
+
+		implementationPkg := t.Traverser.Packages.FindOrAdd(implementationName[:lastDot])
+		_, err := t.Traverser.Packages.Get(implementationPkg)
+		if err != nil {
+			return nil, "", err
+		}
+
+		obj, err := implementationPkg.FindObjectForName(implementationName[lastDot+1:])
+		if err != nil {
+			return nil, "", err
+		}
+
+		implementation, err := t.Traverser.Type(obj.Type(), implementationPkg).Result()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if hasDiscriminatorMethod {
+			implementation.DiscriminatorValue = discriminatorMethodValue(obj.Type(), implementationPkg, discriminator.Method)
+		} else if tagProperty, value, ok := discriminatorTagValue(obj.Type()); ok && (property == "" || property == tagProperty) {
+			property = tagProperty
+			implementation.DiscriminatorValue = value
+		}
+
+		implementations = append(implementations, implementation)
+	}
+
+	return implementations, property, nil
+}
+
+// discoverImplementations finds the concrete types in the main module that implement the interface, by their full name.
+// Interfaces without methods, or outside the main module, aren't discovered as every type would implement them or their implementations aren't known.
+func (t *TypeTraverser) discoverImplementations(named *types.Named, pkg *PackageNode) ([]string, error) {
+	iface, ok := named.Underlying().(*types.Interface)
+	if !ok || iface.NumMethods() == 0 || pkg.Package == nil || pkg.Package.Module == nil || !pkg.Package.Module.Main {
+		return nil, nil
+	}
+
+	modulePackages, err := LoadModule(pkg.Package.Module.Path, t.Traverser.Packages.workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	implementationNames := make([]string, 0)
+	for _, modulePackage := range modulePackages {
+		// The interface needs to come from the same load as the types, so it is found through the package's own imports where possible
+		moduleIface := iface
+		if ifaceObj := lookupInterface(modulePackage, pkg.Package.PkgPath, named.Obj().Name()); ifaceObj != nil {
+			moduleIface = ifaceObj
+		}
+
+		scope := modulePackage.Types.Scope()
+		for _, name := range scope.Names() {
+			typeName, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || typeName.IsAlias() {
+				continue
+			}
+
+			implementationType, ok := typeName.Type().(*types.Named)
+			if !ok || implementationType.TypeParams().Len() > 0 || types.IsInterface(implementationType) {
+				continue
+			}
+
+			if types.Implements(implementationType, moduleIface) || types.Implements(types.NewPointer(implementationType), moduleIface) {
+				implementationNames = append(implementationNames, modulePackage.PkgPath+"."+name)
+			}
+		}
+	}
+
+	t.Traverser.Log.Debug().Str("interface", named.Obj().Name()).Strs("implementations", implementationNames).Msg("Discovered interface implementations")
+
+	return implementationNames, nil
+}
+
+// lookupInterface finds the interface in the package, or in the packages it imports directly.
+func lookupInterface(pkg *packages.Package, ifacePkgPath string, ifaceName string) *types.Interface {
+	ifacePkg := pkg
+	if pkg.PkgPath != ifacePkgPath {
+		var ok bool
+		ifacePkg, ok = pkg.Imports[ifacePkgPath]
+		if !ok || ifacePkg.Types == nil {
+			return nil
+		}
+	}
+
+	obj := ifacePkg.Types.Scope().Lookup(ifaceName)
+	if obj == nil {
+		return nil
+	}
+
+	iface, _ := obj.Type().Underlying().(*types.Interface)
+	return iface
+}
+
+// discriminatorTagValue finds the field of the struct with the discriminator tag, returning the name of its property and the value of the tag.
+func discriminatorTagValue(implementationType types.Type) (string, string, bool) {
+	structType, ok := implementationType.Underlying().(*types.Struct)
+	if !ok {
+		return "", "", false
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		value, ok := reflect.StructTag(structType.Tag(i)).Lookup(DiscriminatorTag)
+		if !ok {
+			continue
+		}
+
+		field := structType.Field(i)
+		bindingTags, _ := ParseStructTag(field.Name(), structType.Tag(i))
+
+		property := field.Name()
+		if jsonTag, ok := bindingTags[JSONBindingTag]; ok {
+			property = jsonTag.Name
+		}
+
+		return property, value, true
+	}
+
+	return "", "", false
+}
+
+// discriminatorMethodValue finds the constant returned by the method of the implementation.
+// If the method can't be found, or doesn't return a constant, the value is empty.
+func discriminatorMethodValue(implementationType types.Type, pkg *PackageNode, method string) string {
+	funcDecl, err := pkg.FindMethodDecl(implementationType, method)
+	if err != nil {
+		return ""
+	}
+
+	for _, stmt := range funcDecl.Body.List {
+		returnStmt, ok := stmt.(*ast.ReturnStmt)
+		if !ok || len(returnStmt.Results) != 1 {
+			continue
+		}
+
+		value := pkg.Package.TypesInfo.Types[returnStmt.Results[0]].Value
+		if value == nil {
+			return ""
+		}
+
+		if value.Kind() == constant.String {
+			return constant.StringVal(value)
+		}
+
+		return value.ExactString()
+	}
+
+	return ""
+}
@@ -0,0 +1,80 @@
+package astTraversal
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeTraverser_Implementations(t *testing.T) {
+	baseTraverser, err := CreateTraverserFromTestFile("usefulTypes.go")
+	assert.NoError(t, err)
+
+	_, err = baseTraverser.Packages.Get(baseTraverser.ActiveFile().Package)
+	assert.NoError(t, err)
+
+	logger := zerolog.Nop()
+	baseTraverser.SetLog(&logger)
+
+	components := make(map[string]Result)
+	baseTraverser.SetAddComponentFunction(func(result Result) error {
+		components[result.Name] = result
+		return nil
+	})
+
+	const pkgPath = "github.com/ls6-events/astra/astTraversal/testfiles"
+
+	shape, err := baseTraverser.ActiveFile().Package.FindObjectForName("Shape")
+	assert.NoError(t, err)
+
+	t.Run("without implementations", func(t *testing.T) {
+		_, err := baseTraverser.Type(shape.Type(), baseTraverser.ActiveFile().Package).Result()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "any", components["Shape"].Type)
+		assert.Empty(t, components["Shape"].Implementations)
+	})
+
+	t.Run("with registered implementations and a discriminator tag", func(t *testing.T) {
+		baseTraverser.SetInterfaces(Interfaces{
+			Implementations: map[string][]string{
+				pkgPath + ".Shape": {pkgPath + ".Circle", pkgPath + ".Square"},
+			},
+		})
+
+		_, err := baseTraverser.Type(shape.Type(), baseTraverser.ActiveFile().Package).Result()
+		assert.NoError(t, err)
+
+		implementations := components["Shape"].Implementations
+		assert.Len(t, implementations, 2)
+		assert.Equal(t, "Circle", implementations[0].Type)
+		assert.Equal(t, "circle", implementations[0].DiscriminatorValue)
+		assert.Equal(t, "Square", implementations[1].Type)
+		assert.Empty(t, implementations[1].DiscriminatorValue)
+		assert.Equal(t, "kind", components["Shape"].Discriminator)
+
+		// The implementations are added as components
+		assert.Equal(t, "struct", components["Circle"].Type)
+		assert.Equal(t, "struct", components["Square"].Type)
+	})
+
+	t.Run("with a discriminator method", func(t *testing.T) {
+		baseTraverser.SetInterfaces(Interfaces{
+			Implementations: map[string][]string{
+				pkgPath + ".Shape": {pkgPath + ".Square"},
+			},
+			Discriminators: map[string]InterfaceDiscriminator{
+				pkgPath + ".Shape": {Property: "kind", Method: "Kind"},
+			},
+		})
+
+		_, err := baseTraverser.Type(shape.Type(), baseTraverser.ActiveFile().Package).Result()
+		assert.NoError(t, err)
+
+		implementations := components["Shape"].Implementations
+		assert.Len(t, implementations, 1)
+		assert.Equal(t, "square", implementations[0].DiscriminatorValue)
+		assert.Equal(t, "kind", components["Shape"].Discriminator)
+	})
+}
@@ -2,44 +2,160 @@ package astTraversal
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/packages"
 )
 
-var cachedPackages = make(map[string]*packages.Package)
+// loadMode is the information loaded for each package.
+// The dependencies are loaded with their syntax and types too, so the types can be followed into other packages.
+const loadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedImports |
+	packages.NeedDeps |
+	packages.NeedExportFile |
+	packages.NeedTypes |
+	packages.NeedSyntax |
+	packages.NeedTypesInfo |
+	packages.NeedTypesSizes |
+	packages.NeedModule
+
+var (
+	// cachedPackagesMu guards cachedPackages and loadingPackages, as the routes can be parsed concurrently.
+	cachedPackagesMu sync.Mutex
+	cachedPackages   = make(map[string]*packages.Package)
+	// loadingPackages holds a channel for each package that is currently being loaded, which is closed once it has finished loading.
+	loadingPackages = make(map[string]chan struct{})
+
+	// loadedModulesMu guards loadedModules, so each module is only loaded once even if its packages are needed by concurrent routes.
+	loadedModulesMu sync.Mutex
+	loadedModules   = make(map[string]struct{})
+)
 
 // LoadPackage loads a package from a path.
 // Because of the way the packages.Load function works, we cache the packages to avoid loading the same package multiple times.
+// It is safe to call concurrently, if the package is already being loaded it will wait for that to finish rather than loading it again.
 func LoadPackage(pkgPath string, workDir string) (*packages.Package, error) {
-	if pkg, ok := cachedPackages[pkgPath]; ok {
-		return pkg, nil
+	cachedPackagesMu.Lock()
+	for {
+		if pkg, ok := cachedPackages[pkgPath]; ok {
+			cachedPackagesMu.Unlock()
+			return pkg, nil
+		}
+
+		loading, ok := loadingPackages[pkgPath]
+		if !ok {
+			break
+		}
+
+		cachedPackagesMu.Unlock()
+		<-loading
+		cachedPackagesMu.Lock()
 	}
 
+	loading := make(chan struct{})
+	loadingPackages[pkgPath] = loading
+	cachedPackagesMu.Unlock()
+
 	pkg, err := LoadPackageNoCache(pkgPath, workDir)
+
+	cachedPackagesMu.Lock()
+	delete(loadingPackages, pkgPath)
+	if err == nil {
+		cachedPackages[pkgPath] = pkg
+	}
+	cachedPackagesMu.Unlock()
+	close(loading)
+
 	if err != nil {
 		return nil, err
 	}
 
-	cachedPackages[pkgPath] = pkg
-
 	return pkg, nil
 }
 
+// LoadPackages loads multiple packages from their paths in a single call, adding them and all of their dependencies to the cache.
+// This is much faster than loading each of the packages separately, as the dependencies they share are only type checked once.
+// Packages that are already cached are skipped, and packages with errors aren't cached, so LoadPackage can report the errors when it loads them.
+func LoadPackages(pkgPaths []string, workDir string) error {
+	cachedPackagesMu.Lock()
+	toLoad := make([]string, 0, len(pkgPaths))
+	for _, pkgPath := range pkgPaths {
+		if _, ok := cachedPackages[pkgPath]; ok || slices.Contains(toLoad, pkgPath) {
+			continue
+		}
+		toLoad = append(toLoad, pkgPath)
+	}
+	cachedPackagesMu.Unlock()
+
+	if len(toLoad) == 0 {
+		return nil
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: loadMode,
+		Dir:  workDir,
+	}, toLoad...)
+	if err != nil {
+		return err
+	}
+
+	cachedPackagesMu.Lock()
+	defer cachedPackagesMu.Unlock()
+
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if len(pkg.Errors) > 0 {
+			return
+		}
+
+		if _, ok := cachedPackages[pkg.PkgPath]; !ok {
+			cachedPackages[pkg.PkgPath] = pkg
+		}
+	})
+
+	return nil
+}
+
+// LoadModule loads every package in the module (other than the packages with errors) into the cache, returning the cached packages in the module sorted by their path.
+// It is used to search the module for types, such as the implementations of an interface, that might not be used by any of the handlers.
+func LoadModule(modulePath string, workDir string) ([]*packages.Package, error) {
+	loadedModulesMu.Lock()
+	defer loadedModulesMu.Unlock()
+
+	if _, ok := loadedModules[modulePath]; !ok {
+		err := LoadPackages([]string{modulePath + "/..."}, workDir)
+		if err != nil {
+			return nil, err
+		}
+
+		loadedModules[modulePath] = struct{}{}
+	}
+
+	cachedPackagesMu.Lock()
+	defer cachedPackagesMu.Unlock()
+
+	modulePackages := make([]*packages.Package, 0)
+	for _, pkg := range cachedPackages {
+		if pkg.Module != nil && pkg.Module.Path == modulePath {
+			modulePackages = append(modulePackages, pkg)
+		}
+	}
+
+	slices.SortFunc(modulePackages, func(a, b *packages.Package) int {
+		return strings.Compare(a.PkgPath, b.PkgPath)
+	})
+
+	return modulePackages, nil
+}
+
 // LoadPackageNoCache loads a package from a path.
 // This function will not use the cache when loading the package.
 func LoadPackageNoCache(pkgPath string, workDir string) (*packages.Package, error) {
 	pkgs, err := packages.Load(&packages.Config{
-		Mode: packages.NeedName |
-			packages.NeedFiles |
-			packages.NeedImports |
-			packages.NeedDeps |
-			packages.NeedExportFile |
-			packages.NeedTypes |
-			packages.NeedSyntax |
-			packages.NeedTypesInfo |
-			packages.NeedTypesSizes |
-			packages.NeedModule,
-		Dir: workDir,
+		Mode: loadMode,
+		Dir:  workDir,
 	}, pkgPath)
 	if err != nil {
 		return nil, err
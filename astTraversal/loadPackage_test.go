@@ -3,6 +3,7 @@ package astTraversal
 import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/tools/go/packages"
+	"sync"
 	"testing"
 )
 
@@ -38,3 +39,58 @@ func TestLoadPackage(t *testing.T) {
 		assert.Nil(t, pkg)
 	})
 }
+
+func TestLoadPackage_Concurrent(t *testing.T) {
+	cachedPackages = make(map[string]*packages.Package)
+
+	existingPkg := "strings"
+
+	var wg sync.WaitGroup
+	results := make([]*packages.Package, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pkg, err := LoadPackage(existingPkg, ".")
+			assert.NoError(t, err)
+			results[i] = pkg
+		}(i)
+	}
+	wg.Wait()
+
+	// The package should only have been loaded once
+	for _, pkg := range results {
+		assert.Same(t, results[0], pkg)
+	}
+	assert.Len(t, cachedPackages, 1)
+	assert.Len(t, loadingPackages, 0)
+}
+
+func TestLoadPackages(t *testing.T) {
+	cachedPackages = make(map[string]*packages.Package)
+
+	t.Run("should load the packages and their dependencies", func(t *testing.T) {
+		err := LoadPackages([]string{"time", "strconv"}, ".")
+		assert.NoError(t, err)
+
+		assert.Contains(t, cachedPackages, "time")
+		assert.Contains(t, cachedPackages, "strconv")
+		// The dependencies are cached too
+		assert.Contains(t, cachedPackages, "errors")
+	})
+
+	t.Run("should load a package from the cache after loading it with the others", func(t *testing.T) {
+		cached := cachedPackages["errors"]
+
+		pkg, err := LoadPackage("errors", ".")
+		assert.NoError(t, err)
+		assert.Same(t, cached, pkg)
+	})
+
+	t.Run("should not cache a package that does not exist", func(t *testing.T) {
+		err := LoadPackages([]string{"github.com/user/project"}, ".")
+		assert.NoError(t, err)
+
+		assert.NotContains(t, cachedPackages, "github.com/user/project")
+	})
+}
@@ -0,0 +1,46 @@
+package astTraversal
+
+import "go/types"
+
+// MarshalerType is the way a named type is serialised, if it implements its own marshalling rather than being serialised by its fields.
+type MarshalerType string
+
+const (
+	NoMarshaler MarshalerType = ""
+	// JSONMarshaler is a type that implements json.Marshaler, so it could be serialised as anything
+	JSONMarshaler MarshalerType = "json"
+	// TextMarshaler is a type that implements encoding.TextMarshaler (but not json.Marshaler), so it is serialised as a string
+	TextMarshaler MarshalerType = "text"
+)
+
+// findMarshaler finds whether the named type implements json.Marshaler or encoding.TextMarshaler.
+// json.Marshaler takes precedence, as it does when encoding, and the methods of the pointer are included as the value is usually addressable.
+func findMarshaler(named *types.Named) MarshalerType {
+	methodSet := types.NewMethodSet(types.NewPointer(named))
+
+	if hasMarshalMethod(methodSet, "MarshalJSON") {
+		return JSONMarshaler
+	}
+
+	if hasMarshalMethod(methodSet, "MarshalText") {
+		return TextMarshaler
+	}
+
+	return NoMarshaler
+}
+
+// hasMarshalMethod checks whether the method set has the method with the signature func() ([]byte, error).
+func hasMarshalMethod(methodSet *types.MethodSet, name string) bool {
+	selection := methodSet.Lookup(nil, name)
+	if selection == nil {
+		return false
+	}
+
+	signature, ok := selection.Type().(*types.Signature)
+	if !ok || signature.Params().Len() != 0 || signature.Results().Len() != 2 {
+		return false
+	}
+
+	return types.Identical(signature.Results().At(0).Type(), types.NewSlice(types.Typ[types.Byte])) &&
+		types.Identical(signature.Results().At(1).Type(), types.Universe.Lookup("error").Type())
+}
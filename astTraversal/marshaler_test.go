@@ -0,0 +1,34 @@
+package astTraversal
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMarshaler(t *testing.T) {
+	baseTraverser, err := CreateTraverserFromTestFile("usefulTypes.go")
+	assert.NoError(t, err)
+
+	_, err = baseTraverser.Packages.Get(baseTraverser.ActiveFile().Package)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		typeName string
+		expected MarshalerType
+	}{
+		{typeName: "MyStruct", expected: NoMarshaler},
+		{typeName: "Money", expected: TextMarshaler},
+		{typeName: "Timestamp", expected: JSONMarshaler},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.typeName, func(t *testing.T) {
+			obj, err := baseTraverser.ActiveFile().Package.FindObjectForName(testCase.typeName)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, findMarshaler(obj.Type().(*types.Named)))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package astTraversal
 
 import (
 	"go/ast"
+	"sort"
 	"strings"
 	"sync"
 
@@ -11,14 +12,18 @@ import (
 type PackagePathLoader func(path string) (string, error)
 
 type PackageManager struct {
-	mu          sync.Mutex
+	mu          *sync.Mutex
 	tree        *PackageNode
 	workDir     string
 	pathLoaders []PackagePathLoader
+
+	// used is the set of packages populated through the package manager, if it is tracking them
+	used map[*PackageNode]struct{}
 }
 
 func NewPackageManager(workDir string) *PackageManager {
 	return &PackageManager{
+		mu:      &sync.Mutex{},
 		workDir: workDir,
 		tree: &PackageNode{
 			Edges: make([]*PackageNode, 0),
@@ -30,6 +35,32 @@ func (pm *PackageManager) AddPathLoader(loader PackagePathLoader) {
 	pm.pathLoaders = append(pm.pathLoaders, loader)
 }
 
+// Track returns a package manager that shares its packages with this one, but also records every package that is populated through it.
+// A tracking package manager should only be used by one traverser at a time.
+func (pm *PackageManager) Track() *PackageManager {
+	return &PackageManager{
+		mu:          pm.mu,
+		tree:        pm.tree,
+		workDir:     pm.workDir,
+		pathLoaders: pm.pathLoaders,
+		used:        make(map[*PackageNode]struct{}),
+	}
+}
+
+// Used returns the packages that have been populated through a tracking package manager, sorted by their path.
+func (pm *PackageManager) Used() []*PackageNode {
+	used := make([]*PackageNode, 0, len(pm.used))
+	for n := range pm.used {
+		used = append(used, n)
+	}
+
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].Path() < used[j].Path()
+	})
+
+	return used
+}
+
 func (pm *PackageManager) AddPackage(pkgPath string) *PackageNode {
 	pathItems := strings.Split(pkgPath, "/")
 
@@ -62,17 +93,41 @@ func (pm *PackageManager) AddPackage(pkgPath string) *PackageNode {
 	return currNode
 }
 
+// Load loads the packages from their paths in a single call, so the dependencies they share are only loaded once.
+// Each of the packages will then be found in the cache when it is first used.
+func (pm *PackageManager) Load(pkgPaths ...string) error {
+	paths := make([]string, 0, len(pkgPaths))
+	for _, pkgPath := range pkgPaths {
+		paths = append(paths, pm.loadPath(pkgPath))
+	}
+
+	return LoadPackages(paths, pm.workDir)
+}
+
+// loadPath returns the path used to load the package, using the first path loader that succeeds.
+func (pm *PackageManager) loadPath(path string) string {
+	for _, loader := range pm.pathLoaders {
+		newPath, err := loader(path)
+		if err == nil {
+			return newPath
+		}
+	}
+
+	return path
+}
+
+// Get populates the package node with its package, loading it if needed.
+// It is safe to call concurrently, as the package manager can be shared between traversers.
 func (pm *PackageManager) Get(n *PackageNode) (*packages.Package, error) {
+	if pm.used != nil {
+		pm.used[n] = struct{}{}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
 	if n.Package == nil {
-		path := n.Path()
-		for _, loader := range pm.pathLoaders {
-			newPath, err := loader(path)
-			if err == nil {
-				path = newPath
-				break
-			}
-		}
-		pkg, err := LoadPackage(path, pm.workDir)
+		pkg, err := LoadPackage(pm.loadPath(n.Path()), pm.workDir)
 		if err != nil {
 			return nil, err
 		}
@@ -3,6 +3,7 @@ package astTraversal
 import (
 	"github.com/stretchr/testify/assert"
 	"go/ast"
+	"golang.org/x/tools/go/packages"
 	"testing"
 )
 
@@ -102,3 +103,41 @@ func TestPackageManager_MapImportSpecs(t *testing.T) {
 	assert.Equal(t, 1, len(fileImports))
 	assert.Equal(t, "github.com/user/project", fileImports[0].Package.Path())
 }
+
+func TestPackageManager_Load(t *testing.T) {
+	cachedPackages = make(map[string]*packages.Package)
+
+	pm := NewPackageManager(".")
+	pm.AddPathLoader(func(path string) (string, error) {
+		if path == "alias" {
+			return "strings", nil
+		}
+		return path, nil
+	})
+
+	err := pm.Load("alias")
+	assert.NoError(t, err)
+	assert.Contains(t, cachedPackages, "strings")
+
+	pkg, err := pm.Get(pm.AddPackage("alias"))
+	assert.NoError(t, err)
+	assert.Same(t, cachedPackages["strings"], pkg)
+}
+
+func TestPackageManager_Track(t *testing.T) {
+	pm := NewPackageManager(".")
+	tracked := pm.Track()
+
+	// The packages are shared with the original package manager
+	node := tracked.AddPackage("strings")
+	assert.Same(t, node, pm.Find("strings"))
+
+	_, err := tracked.Get(node)
+	assert.NoError(t, err)
+	_, err = pm.Get(pm.AddPackage("strconv"))
+	assert.NoError(t, err)
+
+	// Only the packages populated through the tracking package manager are recorded
+	assert.Equal(t, []*PackageNode{node}, tracked.Used())
+	assert.Empty(t, pm.Used())
+}
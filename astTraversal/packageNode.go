@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"sync"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -19,6 +20,9 @@ type PackageNode struct {
 	// TypeDocMap is a map of type names to their documentation
 	// We cache this to save iterating over types every time we need to find the documentation
 	TypeDocMap map[string]string
+
+	// mu guards the lazily populated fields, as the package tree can be shared between traversers
+	mu sync.Mutex
 }
 
 func (p *PackageNode) Path() string {
@@ -200,6 +204,9 @@ func (p *PackageNode) ASTAtPos(pos token.Pos) (ast.Node, error) {
 
 // FindDocForType finds the documentation for a type in the package.
 func (p *PackageNode) FindDocForType(typeName string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.populateTypeDocMap()
 
 	doc, ok := p.TypeDocMap[typeName]
@@ -242,3 +249,27 @@ func (p *PackageNode) populateTypeDocMap() {
 		}
 	}
 }
+
+// FindMethodDecl finds the declaration of a method of a type in the package, if it's declared with a body.
+func (p *PackageNode) FindMethodDecl(t types.Type, method string) (*ast.FuncDecl, error) {
+	if p.Package == nil {
+		return nil, fmt.Errorf("package %s not populated", p.Path())
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, true, p.Package.Types, method)
+	methodObj, ok := obj.(*types.Func)
+	if !ok {
+		return nil, fmt.Errorf("method %s not found for %s in package %s", method, t, p.Path())
+	}
+
+	for _, file := range p.Package.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if ok && funcDecl.Name.Pos() == methodObj.Pos() && funcDecl.Body != nil {
+				return funcDecl, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("declaration of method %s not found for %s in package %s", method, t, p.Path())
+}
@@ -18,6 +18,9 @@ type Result struct {
 	// IsEmbedded is true if the result is embedded in a struct
 	IsEmbedded bool
 
+	// IsPointer is true if the result is a pointer to its type (e.g. for a *string), so it can be null
+	IsPointer bool
+
 	// ConstantValue is the constant value of the result (e.g. for a string)
 	ConstantValue string
 
@@ -25,6 +28,9 @@ type Result struct {
 	// This is used for when the result of a types.Named becomes a types.Basic and has constant values defined in the package
 	EnumValues []any
 
+	// EnumNames is a list of names for the enum values, in the same order, for enums that aren't strings (e.g. for an iota enum with a String method)
+	EnumNames []string
+
 	// MapKeyPackage is the package of the map key (e.g. for a map[string]string)
 	MapKeyPackage *PackageNode
 
@@ -46,10 +52,28 @@ type Result struct {
 	// StructFields is a map of struct fields (e.g. for a struct { Foo string })
 	StructFields map[string]Result
 
+	// Marshaler is how the type is serialised if it implements json.Marshaler or encoding.TextMarshaler
+	Marshaler MarshalerType
+
+	// Implementations is a list of the concrete types that implement a named interface, which it could be one of
+	Implementations []Result
+
+	// Discriminator is the property that tells the implementations of a named interface apart
+	Discriminator string
+
+	// DiscriminatorValue is the value of the discriminator property for an implementation of a named interface
+	DiscriminatorValue string
+
+	// TypeArgs is a list of the type arguments of an instantiated generic type (e.g. Post for a Page[Post])
+	TypeArgs []Result
+
 	StructFieldBindingTags BindingTagMap
 
 	StructFieldValidationTags ValidationTagMap
 
 	// Doc is the documentation of the result
 	Doc string
+
+	// DocMetadata is the metadata taken from the conventions in the documentation (e.g. Deprecated:, Example: and Default:)
+	DocMetadata DocMetadata
 }
@@ -14,15 +14,38 @@ const (
 	JSONBindingTag   BindingTagType = "json"
 	XMLBindingTag    BindingTagType = "xml"
 	YAMLBindingTag   BindingTagType = "yaml"
-	NoBindingTag     BindingTagType = ""
+	TOMLBindingTag   BindingTagType = "toml"
+	// ProtoBufBindingTag and MsgPackBindingTag aren't in BindingTags, as they are only used for the content types of the bodies that are bound (their fields are described by the JSON binding)
+	ProtoBufBindingTag BindingTagType = "protobuf"
+	MsgPackBindingTag  BindingTagType = "msgpack"
+	NoBindingTag       BindingTagType = ""
 )
 
-var BindingTags = []BindingTagType{HeaderBindingTag, FormBindingTag, URIBindingTag, JSONBindingTag, XMLBindingTag, YAMLBindingTag}
+var BindingTags = []BindingTagType{HeaderBindingTag, FormBindingTag, URIBindingTag, JSONBindingTag, XMLBindingTag, YAMLBindingTag, TOMLBindingTag}
 
 type BindingTag struct {
 	Name           string `json:"name,omitempty" yaml:"name,omitempty"`
 	NotShown       bool   `json:"not_shown,omitempty" yaml:"not_shown,omitempty"`
 	ReturnOptional bool   `json:"return_optional,omitempty" yaml:"return_optional,omitempty"`
+	// IsNamed is true if the name is given in the tag, rather than being the name of the field
+	IsNamed bool `json:"is_named,omitempty" yaml:"is_named,omitempty"`
+	// Options are the options of the tag after the name, separated by commas as they are in the tag (i.e. omitempty,string)
+	Options string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// HasOption returns whether the tag has the option (i.e. string for `json:"id,string"`).
+func (b BindingTag) HasOption(option string) bool {
+	if b.Options == "" {
+		return false
+	}
+
+	for _, tagOption := range strings.Split(b.Options, ",") {
+		if tagOption == option {
+			return true
+		}
+	}
+
+	return false
 }
 
 type BindingTagMap map[BindingTagType]BindingTag
@@ -37,8 +60,18 @@ const (
 
 var ValidationTags = []ValidationTagType{GinValidationTag, ValidatorValidationTag}
 
+// ValidationTag is the parsed rule set of a validation tag (i.e. `binding:"required,min=1,dive,email"`).
+// The rules after a dive are applied to each of the items of a slice, array or map, so they are kept in Dive.
 type ValidationTag struct {
-	IsRequired bool `json:"is_required,omitempty" yaml:"is_required,omitempty"`
+	IsRequired bool             `json:"is_required,omitempty" yaml:"is_required,omitempty"`
+	Rules      []ValidationRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Dive       *ValidationTag   `json:"dive,omitempty" yaml:"dive,omitempty"`
+}
+
+// ValidationRule is a single rule of a validation tag, with its parameter if it has one (i.e. min=1 has the name min and the parameter 1).
+type ValidationRule struct {
+	Name  string `json:"name" yaml:"name"`
+	Param string `json:"param,omitempty" yaml:"param,omitempty"`
 }
 
 type ValidationTagMap map[ValidationTagType]ValidationTag
@@ -58,15 +91,15 @@ func ParseStructTag(field string, tag string) (BindingTagMap, ValidationTagMap)
 			newBindingTag.Name = field
 		} else if tagItems[0] != "-" {
 			newBindingTag.Name = tagItems[0]
+			newBindingTag.IsNamed = true
 		} else {
 			newBindingTag.NotShown = true
 		}
 
-		if len(tagItems) > 1 && tagItems[1] == "omitempty" {
-			newBindingTag.ReturnOptional = true
-		} else {
-			newBindingTag.ReturnOptional = false
+		if len(tagItems) > 1 {
+			newBindingTag.Options = strings.Join(tagItems[1:], ",")
 		}
+		newBindingTag.ReturnOptional = newBindingTag.HasOption("omitempty")
 
 		bindingTags[bindingTag] = newBindingTag
 	}
@@ -81,10 +114,52 @@ func ParseStructTag(field string, tag string) (BindingTagMap, ValidationTagMap)
 			continue
 		}
 
-		validationTags[validationTag] = ValidationTag{
-			IsRequired: strings.Contains(tagValue, "required"),
-		}
+		validationTags[validationTag] = parseValidationRules(strings.Split(tagValue, ","))
 	}
 
 	return bindingTags, validationTags
 }
+
+// parseValidationRules parses the rules of a validation tag, split by commas.
+// The required and omitempty rules aren't kept, as they are already covered by IsRequired and the binding tags.
+// Only a required rule before any dive makes the field itself required, as the rules after it are for the elements.
+// Rules with alternatives (i.e. email|url) and the rules for map keys (between keys and endkeys) are skipped, as they can't be represented by a single constraint.
+func parseValidationRules(rules []string) ValidationTag {
+	var validationTag ValidationTag
+
+	inKeys := false
+	for i, rule := range rules {
+		if inKeys {
+			inKeys = rule != "endkeys"
+			continue
+		}
+
+		switch {
+		case rule == "dive":
+			dive := parseValidationRules(rules[i+1:])
+			validationTag.Dive = &dive
+			return validationTag
+		case rule == "keys":
+			inKeys = true
+			continue
+		case rule == "required":
+			validationTag.IsRequired = true
+			continue
+		case rule == "" || rule == "omitempty" || strings.Contains(rule, "|"):
+			continue
+		}
+
+		name, param, _ := strings.Cut(rule, "=")
+
+		// The validator escapes commas and pipes in parameters as their UTF-8 hex representation
+		param = strings.ReplaceAll(param, "0x2C", ",")
+		param = strings.ReplaceAll(param, "0x7C", "|")
+
+		validationTag.Rules = append(validationTag.Rules, ValidationRule{
+			Name:  name,
+			Param: param,
+		})
+	}
+
+	return validationTag
+}
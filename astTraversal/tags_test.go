@@ -20,6 +20,7 @@ func TestParseStructTag(t *testing.T) {
 					Name:           "field1",
 					NotShown:       false,
 					ReturnOptional: false,
+					IsNamed:        true,
 				},
 			},
 			expectedValidationTags: ValidationTagMap{},
@@ -32,6 +33,8 @@ func TestParseStructTag(t *testing.T) {
 					Name:           "field2",
 					NotShown:       false,
 					ReturnOptional: true,
+					IsNamed:        true,
+					Options:        "omitempty",
 				},
 			},
 			expectedValidationTags: ValidationTagMap{},
@@ -56,6 +59,21 @@ func TestParseStructTag(t *testing.T) {
 					Name:           "Field4",
 					NotShown:       false,
 					ReturnOptional: true,
+					Options:        "omitempty",
+				},
+			},
+			expectedValidationTags: ValidationTagMap{},
+		},
+		{
+			field: "FieldString",
+			tag:   `json:"id,string,omitempty"`,
+			expectedBindingTags: BindingTagMap{
+				JSONBindingTag: {
+					Name:           "id",
+					NotShown:       false,
+					ReturnOptional: true,
+					IsNamed:        true,
+					Options:        "string,omitempty",
 				},
 			},
 			expectedValidationTags: ValidationTagMap{},
@@ -88,6 +106,92 @@ func TestParseStructTag(t *testing.T) {
 				},
 			},
 		},
+		{
+			field: "Field8",
+			tag:   `binding:"required,min=1,max=10" validate:"oneof=red green,omitempty"`,
+			expectedBindingTags: BindingTagMap{
+				NoBindingTag: {
+					Name:           "Field8",
+					NotShown:       false,
+					ReturnOptional: false,
+				},
+			},
+			expectedValidationTags: ValidationTagMap{
+				GinValidationTag: {
+					IsRequired: true,
+					Rules: []ValidationRule{
+						{Name: "min", Param: "1"},
+						{Name: "max", Param: "10"},
+					},
+				},
+				ValidatorValidationTag: {
+					Rules: []ValidationRule{
+						{Name: "oneof", Param: "red green"},
+					},
+				},
+			},
+		},
+		{
+			field: "Field9",
+			tag:   `validate:"min=1,email|url,keys,max=3,endkeys,dive,required,contains=0x2C"`,
+			expectedBindingTags: BindingTagMap{
+				NoBindingTag: {
+					Name:           "Field9",
+					NotShown:       false,
+					ReturnOptional: false,
+				},
+			},
+			expectedValidationTags: ValidationTagMap{
+				ValidatorValidationTag: {
+					Rules: []ValidationRule{
+						{Name: "min", Param: "1"},
+					},
+					Dive: &ValidationTag{
+						IsRequired: true,
+						Rules: []ValidationRule{
+							{Name: "contains", Param: ","},
+						},
+					},
+				},
+			},
+		},
+		{
+			field: "Field10",
+			tag:   `validate:"dive,required"`,
+			expectedBindingTags: BindingTagMap{
+				NoBindingTag: {
+					Name:           "Field10",
+					NotShown:       false,
+					ReturnOptional: false,
+				},
+			},
+			expectedValidationTags: ValidationTagMap{
+				ValidatorValidationTag: {
+					Dive: &ValidationTag{
+						IsRequired: true,
+					},
+				},
+			},
+		},
+		{
+			field: "Field11",
+			tag:   `validate:"required_if=Status active,max=10"`,
+			expectedBindingTags: BindingTagMap{
+				NoBindingTag: {
+					Name:           "Field11",
+					NotShown:       false,
+					ReturnOptional: false,
+				},
+			},
+			expectedValidationTags: ValidationTagMap{
+				ValidatorValidationTag: {
+					Rules: []ValidationRule{
+						{Name: "required_if", Param: "Status active"},
+						{Name: "max", Param: "10"},
+					},
+				},
+			},
+		},
 		{
 			field: "Field7",
 			tag:   ``,
@@ -116,3 +220,26 @@ func TestParseStructTag(t *testing.T) {
 		})
 	}
 }
+
+func TestBindingTag_HasOption(t *testing.T) {
+	bindingTag := BindingTag{Name: "id", Options: "string,omitempty"}
+
+	testCases := []struct {
+		bindingTag BindingTag
+		option     string
+		expected   bool
+	}{
+		{bindingTag: bindingTag, option: "string", expected: true},
+		{bindingTag: bindingTag, option: "omitempty", expected: true},
+		{bindingTag: bindingTag, option: "omitzero", expected: false},
+		{bindingTag: BindingTag{Name: "id"}, option: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("option="+tc.option, func(t *testing.T) {
+			if tc.bindingTag.HasOption(tc.option) != tc.expected {
+				t.Errorf("Expected HasOption(%q) to be %t", tc.option, tc.expected)
+			}
+		})
+	}
+}
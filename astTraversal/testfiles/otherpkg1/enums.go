@@ -0,0 +1,65 @@
+package otherpkg1
+
+type Colour string
+
+const (
+	ColourRed   Colour = "red"
+	ColourGreen Colour = "green"
+	// ColourDefault is an alias, so it isn't a separate value
+	ColourDefault = ColourRed
+)
+
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityMedium
+	PriorityHigh
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityMedium:
+		return "medium"
+	case PriorityHigh:
+		return "high"
+	}
+
+	return "unknown"
+}
+
+type Level int
+
+const (
+	LevelDebug Level = iota + 1
+	LevelInfo
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+type Size uint8
+
+const (
+	SizeSmall Size = iota
+	SizeLarge
+)
+
+func (s Size) String() string {
+	return [...]string{"small", "large"}[s]
+}
+
+type Weekday int
+
+const (
+	Monday Weekday = iota
+	Tuesday
+)
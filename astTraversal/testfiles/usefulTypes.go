@@ -25,3 +25,75 @@ func (m *MyStruct) SayHello() {
 func (m *MyStruct) ExternalPackage() {
 	_ = otherpkg1.Foo{}
 }
+
+// Page is a generic struct.
+type Page[T any] struct {
+	Items []T
+	Total int
+}
+
+// Money is serialised as text.
+type Money struct {
+	Amount int64
+}
+
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte("0.00"), nil
+}
+
+// Timestamp is serialised by its own JSON method.
+type Timestamp struct {
+	Seconds int64
+}
+
+func (t *Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte("0"), nil
+}
+
+// Shape is an interface.
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Kind   string  `json:"kind" discriminator:"circle"`
+	Radius float64 `json:"radius"`
+}
+
+func (c Circle) Area() float64 {
+	return 3 * c.Radius * c.Radius
+}
+
+const squareKind = "square"
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+func (s *Square) Kind() string {
+	return squareKind
+}
+
+// TreeNode is a recursive struct.
+type TreeNode struct {
+	Parent   *TreeNode
+	Children []TreeNode
+}
+
+type audit struct {
+	CreatedBy string
+}
+
+// Label is embedded in Labelled as a field named after the type.
+type Label string
+
+// Labelled embeds a struct that is unexported, a pointer to a struct and a type that isn't a struct.
+type Labelled struct {
+	audit
+	*MyStruct
+	Label
+}
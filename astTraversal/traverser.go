@@ -14,6 +14,9 @@ type BaseTraverser struct {
 	Packages           *PackageManager
 	shouldAddComponent bool
 	addComponent       func(result Result) error
+	interfaces         Interfaces
+	// visitingTypes is the set of named types (by their full name) that are being traversed to add them as components, so recursive types aren't traversed again
+	visitingTypes map[string]struct{}
 }
 
 func New(workDir string) *BaseTraverser {
@@ -23,11 +26,37 @@ func New(workDir string) *BaseTraverser {
 	}
 }
 
+// visit marks a named type as being traversed, returning false if it's already being traversed (i.e. it refers to itself through its fields).
+func (t *BaseTraverser) visit(fullName string) bool {
+	if _, ok := t.visitingTypes[fullName]; ok {
+		return false
+	}
+
+	if t.visitingTypes == nil {
+		t.visitingTypes = make(map[string]struct{})
+	}
+	t.visitingTypes[fullName] = struct{}{}
+
+	return true
+}
+
+// leave marks a named type as no longer being traversed.
+func (t *BaseTraverser) leave(fullName string) {
+	delete(t.visitingTypes, fullName)
+}
+
 func (t *BaseTraverser) SetLog(log *zerolog.Logger) *BaseTraverser {
 	t.Log = log
 	return t
 }
 
+// SetPackageManager sets the package manager used to find and load the packages.
+// This allows a package manager to be shared between traversers, so each package is only populated once.
+func (t *BaseTraverser) SetPackageManager(packages *PackageManager) *BaseTraverser {
+	t.Packages = packages
+	return t
+}
+
 func (t *BaseTraverser) ActiveFile() *FileNode {
 	return t.activeFile
 }
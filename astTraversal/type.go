@@ -2,10 +2,7 @@ package astTraversal
 
 import (
 	"go/ast"
-	"go/token"
 	"go/types"
-	"strconv"
-	"strings"
 )
 
 type TypeTraverser struct {
@@ -42,89 +39,11 @@ func (t *TypeTraverser) Result() (Result, error) {
 		// If the name isn't empty, it's a named type
 		// Therefore it has the potential to be an enum
 		if t.name != "" {
-			// Get the package
-			_, err := t.Traverser.Packages.Get(t.Package)
+			var err error
+			result.EnumValues, result.EnumNames, err = t.enumValues(n)
 			if err != nil {
 				return Result{}, err
 			}
-
-			// Iterate through the package's AST to find the enum values
-			// We start by iterating over every file in the package
-			for _, file := range t.Package.Package.Syntax {
-				// Then we iterate over every declaration in the file
-				for _, decl := range file.Decls {
-					// If the declaration is a GenDecl, it's a const/var declaration
-					if genDecl, ok := decl.(*ast.GenDecl); ok {
-						// If the declaration isn't a const, we skip it (we're only looking for constants)
-						if genDecl.Tok != token.CONST {
-							continue
-						}
-
-						// If the declaration is a const, we iterate over every spec
-						for _, spec := range genDecl.Specs {
-							// If the spec is a ValueSpec, we check if the type is the same as the named type
-							if valueSpec, ok := spec.(*ast.ValueSpec); ok {
-								// If the type is the same as the named type, we iterate over every value
-								if valueSpec.Type != nil {
-									// We check this by comparing the name of the type to the name of the named type
-									// It must be an Ident, otherwise it's not a named type, or it's from another package, not the one we're looking for
-									if ident, ok := valueSpec.Type.(*ast.Ident); ok {
-										if ident.Name == t.name {
-											// We iterate over every value in the value spec
-											for _, value := range valueSpec.Values {
-												// If the value is a basic literal, we add it to the enum values
-												if basicLit, ok := value.(*ast.BasicLit); ok {
-													// Switch over the basic literal's kind to determine the type of the value
-													// And format it accordingly
-													switch n.Kind() {
-													case types.String:
-														result.EnumValues = append(result.EnumValues, strings.Trim(basicLit.Value, "\""))
-													case types.Int:
-														i, err := strconv.Atoi(basicLit.Value)
-														if err != nil {
-															continue
-														}
-
-														result.EnumValues = append(result.EnumValues, i)
-													case types.Float32, types.Float64:
-														f, err := strconv.ParseFloat(basicLit.Value, 64)
-														if err != nil {
-															continue
-														}
-
-														result.EnumValues = append(result.EnumValues, f)
-													case types.Bool:
-														b, err := strconv.ParseBool(basicLit.Value)
-														if err != nil {
-															continue
-														}
-
-														result.EnumValues = append(result.EnumValues, b)
-													case types.Int8, types.Int16, types.Int32, types.Int64:
-														i, err := strconv.ParseInt(basicLit.Value, 10, 64)
-														if err != nil {
-															continue
-														}
-
-														result.EnumValues = append(result.EnumValues, i)
-													case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
-														i, err := strconv.ParseUint(basicLit.Value, 10, 64)
-														if err != nil {
-															continue
-														}
-
-														result.EnumValues = append(result.EnumValues, i)
-													}
-												}
-											}
-										}
-									}
-								}
-							}
-						}
-					}
-				}
-			}
 		}
 
 	case *types.Named:
@@ -135,17 +54,72 @@ func (t *TypeTraverser) Result() (Result, error) {
 			if err != nil {
 				return Result{}, err
 			}
+		}
+
+		// Each instantiation of a generic type is its own type, so it needs its own name (e.g. Page[Post] is PagePost)
+		name := n.Obj().Name()
+		var typeArgs []Result
+		if n.TypeArgs().Len() > 0 {
+			name += typeArgumentsName(n.TypeArgs(), n.Obj().Pkg())
 
-			if t.Traverser.shouldAddComponent {
-				namedUnderlyingResult, err := t.Traverser.Type(n.Underlying(), pkg).SetName(n.Obj().Name()).Result()
+			typeArgs = make([]Result, 0, n.TypeArgs().Len())
+			for i := 0; i < n.TypeArgs().Len(); i++ {
+				typeArgResult, err := t.Traverser.Type(n.TypeArgs().At(i), t.Package).Result()
 				if err != nil {
 					return Result{}, err
 				}
 
-				namedUnderlyingResult.Doc, err = t.Doc()
+				typeArgs = append(typeArgs, typeArgResult)
+			}
+		}
+
+		// A type that is already being traversed (i.e. a recursive type) is only referenced, as its component is added once its traversal has finished
+		if pkg != nil && t.Traverser.shouldAddComponent {
+			fullName := pkg.Path() + "." + name
+			if t.Traverser.visit(fullName) {
+				defer t.Traverser.leave(fullName)
+
+				var namedUnderlyingResult Result
+				var err error
+				switch marshaler := findMarshaler(n); marshaler {
+				case JSONMarshaler:
+					// The type is serialised by its own method, so its underlying type doesn't describe it and it could be anything
+					namedUnderlyingResult = Result{
+						Type:      "any",
+						Name:      name,
+						Package:   pkg,
+						Marshaler: marshaler,
+					}
+				case TextMarshaler:
+					namedUnderlyingResult = Result{
+						Type:      "string",
+						Name:      name,
+						Package:   pkg,
+						Marshaler: marshaler,
+					}
+				default:
+					// The underlying type of an instantiation already has the type arguments substituted
+					namedUnderlyingResult, err = t.Traverser.Type(n.Underlying(), pkg).SetName(name).Result()
+					if err != nil {
+						return Result{}, err
+					}
+
+					// A named interface could be one of its implementations, if they can be found
+					if _, ok := n.Underlying().(*types.Interface); ok {
+						namedUnderlyingResult.Implementations, namedUnderlyingResult.Discriminator, err = t.implementations(n, pkg)
+						if err != nil {
+							return Result{}, err
+						}
+					}
+				}
+
+				namedUnderlyingResult.TypeArgs = typeArgs
+
+				doc, err := t.Doc()
 				if err != nil {
 					return Result{}, err
 				}
+				namedUnderlyingResult.Doc, namedUnderlyingResult.DocMetadata = ParseDoc(doc)
 
 				err = t.Traverser.addComponent(namedUnderlyingResult)
 				if err != nil {
@@ -155,11 +129,18 @@ func (t *TypeTraverser) Result() (Result, error) {
 		}
 
 		result = Result{
-			Type:    n.Obj().Name(),
-			Package: pkg,
+			Type:     name,
+			Package:  pkg,
+			TypeArgs: typeArgs,
 		}
 	case *types.Pointer:
-		return t.Traverser.Type(n.Elem(), t.Package).Result()
+		pointerElemResult, err := t.Traverser.Type(n.Elem(), t.Package).Result()
+		if err != nil {
+			return Result{}, err
+		}
+
+		pointerElemResult.IsPointer = true
+		return pointerElemResult, nil
 	case *types.Slice:
 		sliceElemResult, err := t.Traverser.Type(n.Elem(), t.Package).Result()
 		if err != nil {
@@ -205,18 +186,29 @@ func (t *TypeTraverser) Result() (Result, error) {
 		fields := make(map[string]Result)
 		for i := 0; i < n.NumFields(); i++ {
 			f := n.Field(i)
-			name := f.Id()
+			name := f.Name()
 			isExported := f.Exported()
 			isEmbedded := f.Embedded()
 
-			var bindingTag BindingTagMap
-			var validationTags ValidationTagMap
-			if isExported {
-				bindingTag, validationTags = ParseStructTag(name, n.Tag(i))
-			} else {
+			// As in encoding/json, only an embedded struct (or pointer to a struct) has its fields promoted, even if it's unexported as its fields could be exported
+			// Any other embedded type is a field named after the type
+			if isEmbedded {
+				embeddedType := f.Type()
+				if pointer, ok := embeddedType.(*types.Pointer); ok {
+					embeddedType = pointer.Elem()
+				}
+
+				if _, ok := embeddedType.Underlying().(*types.Struct); !ok {
+					isEmbedded = false
+				}
+			}
+
+			if !isExported && !isEmbedded {
 				continue
 			}
 
+			bindingTag, validationTags := ParseStructTag(name, n.Tag(i))
+
 			structFieldResult, err := t.Traverser.Type(f.Type(), t.Package).Result()
 			if err != nil {
 				return Result{}, err
@@ -233,8 +225,8 @@ func (t *TypeTraverser) Result() (Result, error) {
 				node, err := structFieldResult.Package.ASTAtPos(pos)
 				if err == nil && node != nil {
 					if field, ok := node.(*ast.Field); ok {
-						t.Traverser.Log.Debug().Str("field", field.Names[0].Name).Msg("Found doc for field")
-						structFieldResult.Doc = FormatDoc(field.Doc.Text())
+						t.Traverser.Log.Debug().Str("field", name).Msg("Found doc for field")
+						structFieldResult.Doc, structFieldResult.DocMetadata = ParseDoc(FormatDoc(field.Doc.Text()))
 					}
 				}
 			}
@@ -256,6 +248,12 @@ func (t *TypeTraverser) Result() (Result, error) {
 			Type:    "any",
 			Package: t.Package,
 		}
+	case *types.TypeParam:
+		// A type parameter is only found in a generic declaration that hasn't been instantiated, so it could be any type
+		result = Result{
+			Type:    "any",
+			Package: t.Package,
+		}
 	}
 
 	if t.name != "" {
@@ -0,0 +1,63 @@
+package astTraversal
+
+import (
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// typeArgumentsName creates the part of the name of an instantiated generic type that comes from its type arguments (e.g. Post for a Page[Post]).
+// The name is made up of the type arguments in order, so each instantiation has a stable name that is a valid identifier (e.g. Page[[]Post] is PageSlicePost and Pair[string, int] is PairStringInt).
+// A named type argument from a different package to the generic type is prefixed with the name of its package, so that types with the same name from different packages don't collide (e.g. Page[models.Post] is PageModelsPost).
+func typeArgumentsName(typeArgs *types.TypeList, pkg *types.Package) string {
+	var name strings.Builder
+	for i := 0; i < typeArgs.Len(); i++ {
+		name.WriteString(typeArgumentName(typeArgs.At(i), pkg))
+	}
+
+	return name.String()
+}
+
+// typeArgumentName creates the name of a single type argument of a generic type in the package.
+func typeArgumentName(typeArg types.Type, pkg *types.Package) string {
+	switch n := typeArg.(type) {
+	case *types.Basic:
+		return capitalise(n.Name())
+	case *types.Named:
+		name := capitalise(n.Obj().Name())
+		if n.Obj().Pkg() != nil && pkg != nil && n.Obj().Pkg().Path() != pkg.Path() {
+			name = capitalise(n.Obj().Pkg().Name()) + name
+		}
+
+		if n.TypeArgs().Len() > 0 {
+			name += typeArgumentsName(n.TypeArgs(), pkg)
+		}
+
+		return name
+	case *types.TypeParam:
+		return capitalise(n.Obj().Name())
+	case *types.Pointer:
+		return typeArgumentName(n.Elem(), pkg)
+	case *types.Slice:
+		return "Slice" + typeArgumentName(n.Elem(), pkg)
+	case *types.Array:
+		return "Array" + typeArgumentName(n.Elem(), pkg)
+	case *types.Map:
+		return "Map" + typeArgumentName(n.Key(), pkg) + typeArgumentName(n.Elem(), pkg)
+	case *types.Struct:
+		return "Struct"
+	default:
+		return "Any"
+	}
+}
+
+// capitalise makes the first letter of the name upper case.
+func capitalise(name string) string {
+	if name == "" {
+		return name
+	}
+
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
@@ -0,0 +1,63 @@
+package astTraversal
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeArgumentName(t *testing.T) {
+	pkg := types.NewPackage("github.com/ls6-events/astra/example", "example")
+	post := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Post", nil), types.NewStruct(nil, nil), nil)
+
+	typeParam := types.NewTypeParam(types.NewTypeName(token.NoPos, pkg, "T", nil), types.NewInterfaceType(nil, nil))
+	page := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Page", nil), nil, nil)
+	page.SetTypeParams([]*types.TypeParam{typeParam})
+	page.SetUnderlying(types.NewStruct([]*types.Var{types.NewField(token.NoPos, pkg, "Items", types.NewSlice(typeParam), false)}, nil))
+
+	pagePost, err := types.Instantiate(nil, page, []types.Type{post}, true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Post", typeArgumentsName(pagePost.(*types.Named).TypeArgs(), pkg))
+
+	testCases := []struct {
+		name     string
+		typeArg  types.Type
+		expected string
+	}{
+		{name: "Named", typeArg: post, expected: "Post"},
+		{name: "Basic", typeArg: types.Typ[types.String], expected: "String"},
+		{name: "Pointer", typeArg: types.NewPointer(post), expected: "Post"},
+		{name: "Slice", typeArg: types.NewSlice(post), expected: "SlicePost"},
+		{name: "Array", typeArg: types.NewArray(post, 2), expected: "ArrayPost"},
+		{name: "Map", typeArg: types.NewMap(types.Typ[types.String], post), expected: "MapStringPost"},
+		{name: "Generic", typeArg: pagePost, expected: "PagePost"},
+		{name: "Interface", typeArg: types.NewInterfaceType(nil, nil), expected: "Any"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, typeArgumentName(testCase.typeArg, pkg))
+		})
+	}
+
+	t.Run("Same name from different packages", func(t *testing.T) {
+		postsPkg := types.NewPackage("github.com/ls6-events/astra/example/posts", "posts")
+		postsPost := types.NewNamed(types.NewTypeName(token.NoPos, postsPkg, "Post", nil), types.NewStruct(nil, nil), nil)
+
+		draftsPkg := types.NewPackage("github.com/ls6-events/astra/example/drafts", "drafts")
+		draftsPost := types.NewNamed(types.NewTypeName(token.NoPos, draftsPkg, "Post", nil), types.NewStruct(nil, nil), nil)
+
+		pagePostsPost, err := types.Instantiate(nil, page, []types.Type{postsPost}, true)
+		assert.NoError(t, err)
+
+		pageDraftsPost, err := types.Instantiate(nil, page, []types.Type{draftsPost}, true)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "PostsPost", typeArgumentsName(pagePostsPost.(*types.Named).TypeArgs(), pkg))
+		assert.Equal(t, "DraftsPost", typeArgumentsName(pageDraftsPost.(*types.Named).TypeArgs(), pkg))
+		assert.Equal(t, "SliceDraftsPost", typeArgumentName(types.NewSlice(draftsPost), pkg))
+	})
+}
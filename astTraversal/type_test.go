@@ -1,6 +1,7 @@
 package astTraversal
 
 import (
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"go/token"
 	"go/types"
@@ -27,6 +28,7 @@ func TestTypeTraverser_Result(t *testing.T) {
 		res, err := tt.Result()
 		assert.Nil(t, err)
 		assert.Equal(t, "int", res.Type)
+		assert.True(t, res.IsPointer)
 	})
 
 	t.Run("Slice", func(t *testing.T) {
@@ -66,6 +68,98 @@ func TestTypeTraverser_Result(t *testing.T) {
 		assert.Equal(t, "MyStruct", res.Type)
 	})
 
+	t.Run("Generic", func(t *testing.T) {
+		genericType, err := baseTraverser.ActiveFile().Package.FindObjectForName("Page")
+		assert.NoError(t, err)
+
+		namedType, err := baseTraverser.ActiveFile().Package.FindObjectForName("MyStruct")
+		assert.NoError(t, err)
+
+		instance, err := types.Instantiate(nil, genericType.Type(), []types.Type{namedType.Type()}, true)
+		assert.NoError(t, err)
+
+		logger := zerolog.Nop()
+		baseTraverser.SetLog(&logger)
+
+		components := make([]Result, 0)
+		baseTraverser.SetAddComponentFunction(func(result Result) error {
+			components = append(components, result)
+			return nil
+		})
+		defer func() {
+			baseTraverser.addComponent = nil
+			baseTraverser.shouldAddComponent = false
+		}()
+
+		tt := baseTraverser.Type(instance, baseTraverser.ActiveFile().Package)
+		res, err := tt.Result()
+		assert.Nil(t, err)
+		assert.Equal(t, "PageMyStruct", res.Type)
+		assert.Len(t, res.TypeArgs, 1)
+		assert.Equal(t, "MyStruct", res.TypeArgs[0].Type)
+
+		// The instantiation is its own component, with the type argument substituted in its fields
+		var page Result
+		for _, component := range components {
+			if component.Name == "PageMyStruct" {
+				page = component
+			}
+		}
+		assert.Equal(t, "struct", page.Type)
+		assert.Equal(t, "MyStruct", page.StructFields["Items"].SliceType)
+		assert.Equal(t, "int", page.StructFields["Total"].Type)
+		assert.Equal(t, "Page is a generic struct.", strings.TrimSpace(page.Doc))
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		namedType, err := baseTraverser.ActiveFile().Package.FindObjectForName("TreeNode")
+		assert.NoError(t, err)
+
+		logger := zerolog.Nop()
+		baseTraverser.SetLog(&logger)
+
+		components := make([]Result, 0)
+		baseTraverser.SetAddComponentFunction(func(result Result) error {
+			components = append(components, result)
+			return nil
+		})
+		defer func() {
+			baseTraverser.addComponent = nil
+			baseTraverser.shouldAddComponent = false
+		}()
+
+		res, err := baseTraverser.Type(namedType.Type(), baseTraverser.ActiveFile().Package).Result()
+		assert.Nil(t, err)
+		assert.Equal(t, "TreeNode", res.Type)
+
+		// The type is only added once, with its fields referring to itself
+		assert.Len(t, components, 1)
+		assert.Equal(t, "TreeNode", components[0].StructFields["Parent"].Type)
+		assert.Equal(t, "TreeNode", components[0].StructFields["Children"].SliceType)
+	})
+
+	t.Run("Embedded", func(t *testing.T) {
+		namedType, err := baseTraverser.ActiveFile().Package.FindObjectForName("Labelled")
+		assert.NoError(t, err)
+
+		logger := zerolog.Nop()
+		baseTraverser.SetLog(&logger)
+
+		res, err := baseTraverser.Type(namedType.Type().Underlying(), baseTraverser.ActiveFile().Package).Result()
+		assert.Nil(t, err)
+
+		// An unexported embedded struct is kept, as its fields could be exported
+		assert.True(t, res.StructFields["audit"].IsEmbedded)
+		assert.Equal(t, "audit", res.StructFields["audit"].Type)
+
+		assert.True(t, res.StructFields["MyStruct"].IsEmbedded)
+		assert.True(t, res.StructFields["MyStruct"].IsPointer)
+
+		// An embedded type that isn't a struct is a normal field
+		assert.False(t, res.StructFields["Label"].IsEmbedded)
+		assert.Equal(t, "Label", res.StructFields["Label"].StructFieldBindingTags[NoBindingTag].Name)
+	})
+
 	t.Run("Struct", func(t *testing.T) {
 		// Creating a simple struct with a field "Age" of type int
 		fields := []*types.Var{
@@ -37,6 +37,10 @@ func ContentTypeToBindingTag(contentType string) astTraversal.BindingTagType {
 		"application/x-www-form-urlencoded": astTraversal.FormBindingTag,
 		"multipart/form-data":               astTraversal.FormBindingTag,
 		"application/yaml":                  astTraversal.YAMLBindingTag,
+		"application/toml":                  astTraversal.TOMLBindingTag,
+		"application/x-protobuf":            astTraversal.JSONBindingTag, // The fields of protobuf and msgpack messages are described by their JSON names
+		"application/x-msgpack":             astTraversal.JSONBindingTag,
+		"text/event-stream":                 astTraversal.JSONBindingTag, // The data of a Server-Sent Event is encoded as JSON
 	}
 
 	return mimetypeToBindingTagMap[contentType]
@@ -44,10 +48,13 @@ func ContentTypeToBindingTag(contentType string) astTraversal.BindingTagType {
 
 func BindingTagToContentTypes(bindingTag astTraversal.BindingTagType) []string {
 	bindingTagToMimetypeMap := map[astTraversal.BindingTagType][]string{
-		astTraversal.JSONBindingTag: {"application/json"},
-		astTraversal.XMLBindingTag:  {"application/xml"},
-		astTraversal.FormBindingTag: {"application/x-www-form-urlencoded", "multipart/form-data"},
-		astTraversal.YAMLBindingTag: {"application/yaml"},
+		astTraversal.JSONBindingTag:     {"application/json"},
+		astTraversal.XMLBindingTag:      {"application/xml"},
+		astTraversal.FormBindingTag:     {"application/x-www-form-urlencoded", "multipart/form-data"},
+		astTraversal.YAMLBindingTag:     {"application/yaml"},
+		astTraversal.TOMLBindingTag:     {"application/toml"},
+		astTraversal.ProtoBufBindingTag: {"application/x-protobuf"},
+		astTraversal.MsgPackBindingTag:  {"application/x-msgpack"},
 	}
 
 	return bindingTagToMimetypeMap[bindingTag]
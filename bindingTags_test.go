@@ -147,6 +147,14 @@ func TestContentTypeToBindingTag(t *testing.T) {
 	t.Run("application/yaml", func(t *testing.T) {
 		require.Equal(t, astTraversal.YAMLBindingTag, ContentTypeToBindingTag("application/yaml"))
 	})
+
+	t.Run("text/event-stream", func(t *testing.T) {
+		require.Equal(t, astTraversal.JSONBindingTag, ContentTypeToBindingTag("text/event-stream"))
+	})
+
+	t.Run("application/x-msgpack", func(t *testing.T) {
+		require.Equal(t, astTraversal.JSONBindingTag, ContentTypeToBindingTag("application/x-msgpack"))
+	})
 }
 
 func TestBindingTagToContentTypes(t *testing.T) {
@@ -165,4 +173,12 @@ func TestBindingTagToContentTypes(t *testing.T) {
 	t.Run("YAMLBindingTag", func(t *testing.T) {
 		require.Equal(t, []string{"application/yaml"}, BindingTagToContentTypes(astTraversal.YAMLBindingTag))
 	})
+
+	t.Run("ProtoBufBindingTag", func(t *testing.T) {
+		require.Equal(t, []string{"application/x-protobuf"}, BindingTagToContentTypes(astTraversal.ProtoBufBindingTag))
+	})
+
+	t.Run("MsgPackBindingTag", func(t *testing.T) {
+		require.Equal(t, []string{"application/x-msgpack"}, BindingTagToContentTypes(astTraversal.MsgPackBindingTag))
+	})
 }
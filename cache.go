@@ -11,8 +11,8 @@ import (
 )
 
 // The caching mechanism is used to cache the service in a file so that it can be loaded later on
-// At the moment it is only used the CLI to load the service and the files that are needed to be crawled by the AST parser with their respective inputs
-// Plans are for the future to use it as a change only mechanism to only generate the files that have changed since the last build
+// It is used by the CLI to load the service and the files that are needed to be crawled by the AST parser with their respective inputs
+// It is also used as a change only mechanism, where the routes from the previous build are reused if none of the files they depend on have changed (see routeCache.go)
 
 const cacheFileName = "cache.json"
 
@@ -34,10 +34,22 @@ func (s *Service) Cache() error {
 		}
 	}
 
+	err := writeCache(cachePath, s)
+	if err != nil {
+		return err
+	}
+
+	s.Log.Debug().Msg("Cached service")
+	return nil
+}
+
+// writeCache writes the service to the cache file, in the format matching the file extension.
+func writeCache(cachePath string, s *Service) error {
 	f, err := os.Create(cachePath)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
 
 	var cacheStr []byte
 	if strings.HasSuffix(cachePath, ".yaml") || strings.HasSuffix(cachePath, ".yml") {
@@ -50,12 +62,7 @@ func (s *Service) Cache() error {
 	}
 
 	_, err = f.Write(cacheStr)
-	if err != nil {
-		return err
-	}
-
-	s.Log.Debug().Msg("Cached service")
-	return nil
+	return err
 }
 
 // LoadCache Load the service from a file cache.
@@ -86,11 +93,33 @@ func (s *Service) LoadCache() error {
 // If the file does not exist, it will return an error.
 // Requires the path to the cache file.
 func (s *Service) LoadCacheFromCustomPath(cachePath string) error {
-	f, err := os.Open(cachePath)
+	service, err := readCache(cachePath)
 	if err != nil {
 		return err
 	}
 
+	s.Inputs = service.Inputs
+	s.Outputs = service.Outputs
+	s.Config = service.Config
+	s.Routes = service.Routes
+	s.Components = service.Components
+	s.Concurrency = service.Concurrency
+	s.RouteCache = service.RouteCache
+	s.Interfaces = service.Interfaces
+	s.JSONMarshalerFallback = service.JSONMarshalerFallback
+	s.SubstituteTypes = service.SubstituteTypes
+	s.TypePresets = service.TypePresets
+	return nil
+}
+
+// readCache reads the service from the cache file, in the format matching the file extension.
+func readCache(cachePath string) (*Service, error) {
+	f, err := os.Open(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
 	var service Service
 	if strings.HasSuffix(cachePath, ".json") {
 		err = json.NewDecoder(f).Decode(&service)
@@ -101,15 +130,10 @@ func (s *Service) LoadCacheFromCustomPath(cachePath string) error {
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	s.Inputs = service.Inputs
-	s.Outputs = service.Outputs
-	s.Config = service.Config
-	s.Routes = service.Routes
-	s.Components = service.Components
-	return nil
+	return &service, nil
 }
 
 // ClearCache Clear the cache file.
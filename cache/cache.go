@@ -16,3 +16,11 @@ func WithCustomCachePath(cachePath string) astra.Option {
 		s.CachePath = cachePath
 	}
 }
+
+// WithForceRebuild Option to ignore the routes cached from the previous build, so every route is parsed again.
+// The routes will still be cached for the next build.
+func WithForceRebuild() astra.Option {
+	return func(s *astra.Service) {
+		s.ForceRebuild = true
+	}
+}
@@ -27,3 +27,13 @@ func TestWithCustomCachePath(t *testing.T) {
 	require.True(t, service.CacheEnabled)
 	require.Equal(t, "test", service.CachePath)
 }
+
+func TestWithForceRebuild(t *testing.T) {
+	service := &astra.Service{}
+
+	require.False(t, service.ForceRebuild)
+
+	WithForceRebuild()(service)
+
+	require.True(t, service.ForceRebuild)
+}
@@ -3,6 +3,7 @@ package astra
 import (
 	"encoding/json"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra/astTraversal"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 	"os"
@@ -256,6 +257,37 @@ func TestService_LoadCacheFromCustomPath(t *testing.T) {
 		require.Equal(t, "test", topLevelService.Components[0].Package)
 	})
 
+	t.Run("loads the options used when parsing", func(t *testing.T) {
+		topLevelService := &Service{}
+
+		cachedService := Service{
+			Interfaces: astTraversal.Interfaces{
+				Implementations: map[string][]string{
+					"test.Event": {"test.Created"},
+				},
+				Discover: true,
+			},
+			JSONMarshalerFallback: TypeFormat{
+				Type: "object",
+			},
+			SubstituteTypes: map[string]Field{
+				"database/sql.NullString": {
+					Type: "string",
+				},
+			},
+		}
+
+		setupCache("./test-cache.json", cachedService, t)
+		defer cleanupCache("./test-cache.json", t)
+
+		err := topLevelService.LoadCacheFromCustomPath("./test-cache.json")
+		require.NoError(t, err)
+
+		require.Equal(t, cachedService.Interfaces, topLevelService.Interfaces)
+		require.Equal(t, cachedService.JSONMarshalerFallback, topLevelService.JSONMarshalerFallback)
+		require.Equal(t, cachedService.SubstituteTypes, topLevelService.SubstituteTypes)
+	})
+
 	t.Run("returns an error if the file format is not yaml or json", func(t *testing.T) {
 		topLevelService := &Service{}
 
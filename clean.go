@@ -1,8 +1,10 @@
 package astra
 
+import "github.com/ls6-events/astra/astTraversal"
+
 // Clean cleans up the structs.
 // At the moment it only changes the package name of the main package to "main".
-// It also handles the "special" types.
+// It also handles the "special" types, warning about the types that implement json.Marshaler without a custom type mapping.
 // It also caches the service after cleaning.
 func (s *Service) Clean() error {
 	s.Log.Info().Msg("Cleaning up structs")
@@ -14,6 +16,12 @@ func (s *Service) Clean() error {
 
 	for i := 0; i < len(s.Components); i++ {
 		s.Components[i] = s.cleanField(s.Components[i], mainPkg)
+
+		if s.Components[i].Marshaler == astTraversal.JSONMarshaler {
+			if _, ok := s.GetTypeMapping(s.Components[i].Name, s.Components[i].Package); !ok {
+				s.Log.Warn().Str("pkg", s.Components[i].Package).Str("type", s.Components[i].Name).Msg("Type implements json.Marshaler, so its schema can't be inferred and the fallback will be used (add a custom type mapping to document it)")
+			}
+		}
 	}
 
 	for i := 0; i < len(s.Routes); i++ {
@@ -54,6 +62,14 @@ func (s *Service) cleanField(f Field, mainPkg string) Field {
 		f.MapKeyPackage = "main"
 	}
 
+	for i, typeArg := range f.TypeArgs {
+		f.TypeArgs[i] = s.cleanField(typeArg, mainPkg)
+	}
+
+	for i, implementation := range f.Implementations {
+		f.Implementations[i] = s.cleanField(implementation, mainPkg)
+	}
+
 	for k, v := range f.StructFields {
 		f.StructFields[k] = s.cleanField(v, mainPkg)
 	}
@@ -351,5 +351,26 @@ func TestService_cleanField(t *testing.T) {
 			require.Equal(t, "TestKey", newField.StructFields["Test"].MapKeyType)
 			require.Equal(t, "string", newField.StructFields["Test"].MapValueType)
 		})
+
+		t.Run("handles main package name for type arguments", func(t *testing.T) {
+			service := &Service{}
+
+			field := Field{
+				Name:    "PageTestType",
+				Package: "example",
+				Type:    "struct",
+				TypeArgs: []Field{
+					{
+						Type:    "TestType",
+						Package: "not-main",
+					},
+				},
+			}
+
+			newField := service.cleanField(field, "not-main")
+
+			require.Equal(t, "main", newField.TypeArgs[0].Package)
+			require.Equal(t, "TestType", newField.TypeArgs[0].Type)
+		})
 	})
 }
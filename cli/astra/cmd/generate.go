@@ -6,19 +6,24 @@ import (
 	"path"
 
 	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/cache"
 	"github.com/ls6-events/astra/cli"
 	"github.com/spf13/cobra"
 )
 
 var (
-	cacheFile = ".astra/cache.json" // Location of the cache.json file
-	cwd       = "."                 // Current working directory (where main.go is located)
+	cacheFile    = ".astra/cache.json" // Location of the cache.json file
+	cwd          = "."                 // Current working directory (where main.go is located)
+	forceRebuild = false               // Whether to parse every route again, rather than reusing the routes that haven't changed
+	strict       = false               // Whether to exit with an error if any of the routes failed to parse
 )
 
 // generateCmd represents the generate command
 // It is used to generate the service from a cache file
 // It requires the cache file to be passed in, and the working directory of the main.go file
 // By default the cache file is .astra/cache.json and the working directory is the current directory
+// The routes that haven't changed since the last build are reused, unless the force rebuild flag is passed in
+// Routes that fail to parse are summarised rather than stopping the build, unless the strict flag is passed in
 // Example: astra generate -c .astra/cache.json -d .
 var generateCmd = &cobra.Command{
 	Use:   "generate",
@@ -36,7 +41,12 @@ var generateCmd = &cobra.Command{
 			cwd = path.Join(wd, cwd)
 		}
 
-		s := astra.New(cli.WithCLIBuilder(), astra.WithCustomWorkDir(cwd))
+		options := []astra.Option{cli.WithCLIBuilder(), astra.WithCustomWorkDir(cwd), astra.WithLenientParsing()}
+		if forceRebuild {
+			options = append(options, cache.WithForceRebuild())
+		}
+
+		s := astra.New(options...)
 
 		err := s.LoadCacheFromCustomPath(cacheFile)
 		if err != nil {
@@ -50,12 +60,44 @@ var generateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// The .astra directory may not exist if the cache was created elsewhere (i.e. committed to the repository)
+		err = s.Setup()
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to setup service")
+			os.Exit(1)
+		}
+
+		err = rediscoverRoutes(s)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to rediscover routes")
+			os.Exit(1)
+		}
+
 		err = s.CompleteParse()
 		if err != nil {
 			s.Log.Error().Err(err).Msg("Failed to generate service")
 			os.Exit(1)
 		}
 
+		// The routes are cached so the next build can reuse the routes that haven't changed
+		err = s.CacheRoutes(cacheFile)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to cache routes")
+			os.Exit(1)
+		}
+
+		if len(s.Diagnostics) > 0 {
+			for _, diagnostic := range s.Diagnostics {
+				s.Log.Warn().Str("path", diagnostic.Path).Str("method", diagnostic.Method).Str("file", diagnostic.File).Int("line", diagnostic.Line).Err(diagnostic.Cause).Msg("Route failed to parse")
+			}
+			s.Log.Warn().Int("failed", len(s.Diagnostics)).Int("total", len(s.Routes)).Msg("Service built with routes that failed to parse")
+
+			if strict {
+				s.Log.Error().Msg("Routes failed to parse in strict mode")
+				os.Exit(1)
+			}
+		}
+
 		s.Log.Info().Msg("Service built")
 	},
 }
@@ -63,5 +105,7 @@ var generateCmd = &cobra.Command{
 func init() {
 	generateCmd.Flags().StringVarP(&cacheFile, "cache", "c", cacheFile, "Location of the cache.json file")
 	generateCmd.Flags().StringVarP(&cwd, "dir", "d", cwd, "Current working directory (where main.go is located)")
+	generateCmd.Flags().BoolVarP(&strict, "strict", "s", strict, "Exit with an error if any of the routes failed to parse")
+	generateCmd.Flags().BoolVarP(&forceRebuild, "force-rebuild", "f", forceRebuild, "Parse every route again, rather than reusing the routes that haven't changed since the last build")
 	rootCmd.AddCommand(generateCmd)
 }
@@ -32,6 +32,16 @@ func rebindInputs(s *astra.Service) error {
 		switch input.Mode {
 		case inputs.InputModeGin:
 			inputs.WithGinInput(nil)(s)
+		case inputs.InputModeGinStatic:
+			inputs.WithGinStaticInput()(s)
+		case inputs.InputModeEcho:
+			inputs.WithEchoInput(nil)(s)
+		case inputs.InputModeChi:
+			inputs.WithChiInput(nil)(s)
+		case inputs.InputModeNetHTTP:
+			inputs.WithNetHTTPInput(nil)(s)
+		case inputs.InputModeFiber:
+			inputs.WithFiberInput(nil)(s)
 		default:
 			return astra.ErrInputModeNotFound
 		}
@@ -40,6 +50,31 @@ func rebindInputs(s *astra.Service) error {
 	return nil
 }
 
+// rediscoverRoutes is used to create the routes again for the inputs that discover them from the source code
+// The cached routes are discarded, as they may be out of date with the source code
+func rediscoverRoutes(s *astra.Service) error {
+	staticInputs := make([]astra.Input, 0)
+	for _, input := range s.Inputs {
+		if input.Mode == inputs.InputModeGinStatic {
+			staticInputs = append(staticInputs, input)
+		}
+	}
+
+	if len(staticInputs) == 0 {
+		return nil
+	}
+
+	s.Routes = nil
+	for _, input := range staticInputs {
+		err := input.CreateRoutes(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // rebindOutputs is used to rebind the outputs to the service
 // It will have to be updated if more output modes are added
 // It utilises the configuration keys to get the file path for the output
@@ -0,0 +1,47 @@
+package astra
+
+import "fmt"
+
+// Diagnostic is a failure to parse a route.
+// In lenient mode the failures are recorded as diagnostics rather than stopping the parse, and the route is kept with whatever was found before the failure.
+type Diagnostic struct {
+	Path   string `json:"path" yaml:"path"`
+	Method string `json:"method" yaml:"method"`
+	File   string `json:"file" yaml:"file"`
+	Line   int    `json:"line" yaml:"line"`
+	Cause  error  `json:"-" yaml:"-"`
+}
+
+// Error returns the diagnostic as a message, so it can be used as an error.
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%s %s (%s:%d): %s", d.Method, d.Path, d.File, d.Line, d.Cause)
+}
+
+// Unwrap returns the cause of the diagnostic.
+func (d Diagnostic) Unwrap() error {
+	return d.Cause
+}
+
+// WithLenientParsing is an option to keep parsing the rest of the routes when a route fails to parse.
+// Each failure is recorded in the service's diagnostics, and the route is kept with whatever was found before the failure.
+func WithLenientParsing() Option {
+	return func(s *Service) {
+		s.LenientParsing = true
+	}
+}
+
+// addDiagnostic records the failure to parse the route.
+func (s *Service) addDiagnostic(route Route, cause error) {
+	diagnostic := Diagnostic{
+		Path:   route.Path,
+		Method: route.Method,
+		File:   route.File,
+		Line:   route.LineNo,
+		Cause:  cause,
+	}
+
+	s.Log.Warn().Str("path", diagnostic.Path).Str("method", diagnostic.Method).Str("file", diagnostic.File).Int("line", diagnostic.Line).Err(cause).Msg("Route failed to parse, continuing as parsing is lenient")
+
+	defer s.lock()()
+	s.Diagnostics = append(s.Diagnostics, diagnostic)
+}
@@ -0,0 +1,32 @@
+package astra
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnostic(t *testing.T) {
+	cause := errors.New("could not find file")
+	diagnostic := Diagnostic{
+		Path:   "/test",
+		Method: "GET",
+		File:   "handlers.go",
+		Line:   10,
+		Cause:  cause,
+	}
+
+	require.EqualError(t, diagnostic, "GET /test (handlers.go:10): could not find file")
+	require.ErrorIs(t, diagnostic, cause)
+}
+
+func TestWithLenientParsing(t *testing.T) {
+	service := &Service{}
+
+	require.False(t, service.LenientParsing)
+
+	WithLenientParsing()(service)
+
+	require.True(t, service.LenientParsing)
+}
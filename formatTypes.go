@@ -6,6 +6,10 @@ import "maps"
 type TypeFormat struct {
 	Type   string
 	Format string
+	// Formats are the formats of which any one is accepted (i.e. ipv4 or ipv6), for a type that has no single format
+	Formats []string
+	// Nullable is whether the type can be null (i.e. a wrapper such as sql.NullString)
+	Nullable bool
 }
 
 // PredefinedTypeMap is the map of the standard go types that are accepted by OpenAPI.
@@ -106,6 +110,98 @@ var PredefinedTypeMap = map[string]TypeFormat{
 	},
 }
 
+// TypePreset is the name of a curated set of type mappings for commonly used types, which can be added with WithTypePreset.
+type TypePreset string
+
+const (
+	PresetSQL     TypePreset = "sql"     // The nullable types from database/sql
+	PresetNull    TypePreset = "null"    // The nullable and zero types from gopkg.in/guregu/null (v3 to v5)
+	PresetUUID    TypePreset = "uuid"    // The types from github.com/google/uuid and github.com/gofrs/uuid
+	PresetDecimal TypePreset = "decimal" // The types from github.com/shopspring/decimal
+	PresetNetwork TypePreset = "network" // The address types from net and net/netip
+	PresetCommon  TypePreset = "common"  // All the presets above
+)
+
+// sqlPreset is the type mapping of the nullable types from database/sql.
+var sqlPreset = map[string]TypeFormat{
+	"database/sql.NullString":  {Type: "string", Nullable: true},
+	"database/sql.NullInt64":   {Type: "integer", Format: "int64", Nullable: true},
+	"database/sql.NullInt32":   {Type: "integer", Format: "int32", Nullable: true},
+	"database/sql.NullInt16":   {Type: "integer", Format: "int16", Nullable: true},
+	"database/sql.NullByte":    {Type: "integer", Format: "uint8", Nullable: true},
+	"database/sql.NullFloat64": {Type: "number", Format: "float64", Nullable: true},
+	"database/sql.NullBool":    {Type: "boolean", Nullable: true},
+	"database/sql.NullTime":    {Type: "string", Format: "date-time", Nullable: true},
+}
+
+// nullPreset is the type mapping of the types from gopkg.in/guregu/null, for each of its major versions.
+// The types in the zero package are never null, as their zero value is used instead.
+var nullPreset = func() map[string]TypeFormat {
+	preset := make(map[string]TypeFormat)
+	for _, pkg := range []string{"gopkg.in/guregu/null.v3", "gopkg.in/guregu/null.v4", "github.com/guregu/null/v5"} {
+		for name, typeFormat := range map[string]TypeFormat{
+			"String": {Type: "string"},
+			"Int":    {Type: "integer", Format: "int64"},
+			"Float":  {Type: "number", Format: "float64"},
+			"Bool":   {Type: "boolean"},
+			"Time":   {Type: "string", Format: "date-time"},
+		} {
+			preset[pkg+"/zero."+name] = typeFormat
+
+			typeFormat.Nullable = true
+			preset[pkg+"."+name] = typeFormat
+		}
+	}
+
+	return preset
+}()
+
+// uuidPreset is the type mapping of the types from github.com/google/uuid and github.com/gofrs/uuid.
+var uuidPreset = map[string]TypeFormat{
+	"github.com/google/uuid.UUID":       {Type: "string", Format: "uuid"},
+	"github.com/google/uuid.NullUUID":   {Type: "string", Format: "uuid", Nullable: true},
+	"github.com/gofrs/uuid.UUID":        {Type: "string", Format: "uuid"},
+	"github.com/gofrs/uuid.NullUUID":    {Type: "string", Format: "uuid", Nullable: true},
+	"github.com/gofrs/uuid/v5.UUID":     {Type: "string", Format: "uuid"},
+	"github.com/gofrs/uuid/v5.NullUUID": {Type: "string", Format: "uuid", Nullable: true},
+}
+
+// decimalPreset is the type mapping of the types from github.com/shopspring/decimal, which are serialised as strings by default.
+var decimalPreset = map[string]TypeFormat{
+	"github.com/shopspring/decimal.Decimal":     {Type: "string", Format: "decimal"},
+	"github.com/shopspring/decimal.NullDecimal": {Type: "string", Format: "decimal", Nullable: true},
+}
+
+// networkPreset is the type mapping of the address types from net and net/netip.
+// An address can be either IPv4 or IPv6, so it is any of the ipv4 and ipv6 formats.
+var networkPreset = map[string]TypeFormat{
+	"net.IP":             {Type: "string", Formats: []string{"ipv4", "ipv6"}},
+	"net/netip.Addr":     {Type: "string", Formats: []string{"ipv4", "ipv6"}},
+	"net/netip.AddrPort": {Type: "string"},
+	"net/netip.Prefix":   {Type: "string", Format: "cidr"},
+	"net/mail.Address":   {Type: "string", Format: "email"},
+}
+
+// typePresets is the registry of the type mappings of each preset.
+var typePresets = map[TypePreset]map[string]TypeFormat{
+	PresetSQL:     sqlPreset,
+	PresetNull:    nullPreset,
+	PresetUUID:    uuidPreset,
+	PresetDecimal: decimalPreset,
+	PresetNetwork: networkPreset,
+	PresetCommon:  mergeTypeMappings(sqlPreset, nullPreset, uuidPreset, decimalPreset, networkPreset),
+}
+
+// mergeTypeMappings merges the type mappings into one, where the later mappings take precedence.
+func mergeTypeMappings(typeMappings ...map[string]TypeFormat) map[string]TypeFormat {
+	merged := make(map[string]TypeFormat)
+	for _, typeMapping := range typeMappings {
+		maps.Copy(merged, typeMapping)
+	}
+
+	return merged
+}
+
 // WithCustomTypeMapping adds a custom type mapping to the predefined type map.
 func WithCustomTypeMapping(customTypeMap map[string]TypeFormat) Option {
 	return func(service *Service) {
@@ -125,11 +221,40 @@ func WithCustomTypeMappingSingle(key string, valueType string, valueFormat strin
 	}
 }
 
+// WithJSONMarshalerFallback sets the type mapping used for the types that implement json.Marshaler and don't have a custom type mapping.
+// Their schema can't be inferred from their fields, so by default they can be any type.
+func WithJSONMarshalerFallback(valueType string, valueFormat string) Option {
+	return func(service *Service) {
+		service.JSONMarshalerFallback = TypeFormat{
+			Type:   valueType,
+			Format: valueFormat,
+		}
+	}
+}
+
+// WithTypePreset adds the type mappings of the curated presets (i.e. astra.PresetCommon), so the commonly used types don't need a custom type mapping each.
+// The custom type mappings take precedence over the presets, and a preset takes precedence over the presets added before it.
+func WithTypePreset(presets ...TypePreset) Option {
+	return func(service *Service) {
+		service.TypePresets = append(service.TypePresets, presets...)
+	}
+}
+
 // GetTypeMapping returns the type mapping for the given key.
+// The type is looked up in the custom type mapping first, then the presets (the last one added first) and then the predefined type map.
 func (s *Service) GetTypeMapping(key string, pkg string) (TypeFormat, bool) {
 	if s.fullTypeMapping == nil {
 		s.fullTypeMapping = make(map[string]TypeFormat)
 		maps.Copy(s.fullTypeMapping, PredefinedTypeMap)
+		for _, preset := range s.TypePresets {
+			typeMapping, ok := typePresets[preset]
+			if !ok {
+				s.Log.Warn().Str("preset", string(preset)).Msg("Unknown type preset")
+				continue
+			}
+
+			maps.Copy(s.fullTypeMapping, typeMapping)
+		}
 		maps.Copy(s.fullTypeMapping, s.CustomTypeMapping)
 	}
 
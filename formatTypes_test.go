@@ -112,6 +112,17 @@ func TestWithCustomTypeMappingSingle(t *testing.T) {
 	})
 }
 
+func TestWithTypePreset(t *testing.T) {
+	t.Run("adds type presets", func(t *testing.T) {
+		service := &Service{}
+
+		WithTypePreset(PresetSQL)(service)
+		WithTypePreset(PresetUUID, PresetNetwork)(service)
+
+		require.Equal(t, []TypePreset{PresetSQL, PresetUUID, PresetNetwork}, service.TypePresets)
+	})
+}
+
 func TestService_GetTypeMapping(t *testing.T) {
 	t.Run("sets full type mapping if it doesn't exist initially", func(t *testing.T) {
 		service := &Service{
@@ -162,4 +173,73 @@ func TestService_GetTypeMapping(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("returns the type mapping of the presets", func(t *testing.T) {
+		service := &Service{}
+		WithTypePreset(PresetCommon)(service)
+
+		result, ok := service.GetTypeMapping("NullString", "database/sql")
+		require.True(t, ok)
+		require.Equal(t, TypeFormat{
+			Type:     "string",
+			Nullable: true,
+		}, result)
+
+		result, ok = service.GetTypeMapping("Addr", "net/netip")
+		require.True(t, ok)
+		require.Equal(t, TypeFormat{
+			Type:    "string",
+			Formats: []string{"ipv4", "ipv6"},
+		}, result)
+
+		result, ok = service.GetTypeMapping("Int", "gopkg.in/guregu/null.v4")
+		require.True(t, ok)
+		require.Equal(t, TypeFormat{
+			Type:     "integer",
+			Format:   "int64",
+			Nullable: true,
+		}, result)
+
+		result, ok = service.GetTypeMapping("Int", "gopkg.in/guregu/null.v4/zero")
+		require.True(t, ok)
+		require.Equal(t, TypeFormat{
+			Type:   "integer",
+			Format: "int64",
+		}, result)
+	})
+
+	t.Run("doesn't return the type mapping of presets that aren't added", func(t *testing.T) {
+		service := &Service{}
+		WithTypePreset(PresetUUID)(service)
+
+		_, ok := service.GetTypeMapping("NullString", "database/sql")
+		require.False(t, ok)
+	})
+
+	t.Run("prefers the custom type mapping over the presets", func(t *testing.T) {
+		service := &Service{
+			CustomTypeMapping: map[string]TypeFormat{
+				"database/sql.NullString": {
+					Type:   "string",
+					Format: "custom",
+				},
+			},
+		}
+		WithTypePreset(PresetSQL)(service)
+
+		result, ok := service.GetTypeMapping("NullString", "database/sql")
+		require.True(t, ok)
+		require.Equal(t, TypeFormat{
+			Type:   "string",
+			Format: "custom",
+		}, result)
+	})
+
+	t.Run("ignores unknown presets", func(t *testing.T) {
+		service := &Service{}
+		WithTypePreset("unknown")(service)
+
+		_, ok := service.GetTypeMapping("string", "")
+		require.True(t, ok)
+	})
 }
@@ -0,0 +1,108 @@
+package chi
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// walkedRoute is a route found by walking the chi router.
+type walkedRoute struct {
+	method  string
+	path    string
+	handler http.Handler
+}
+
+// CreateRoutes creates routes from a chi router.
+// It will only create the routes and refer to the handler function by name, file and line number.
+// The routes will be populated later by parseRoutes.
+// chi.Walk is used to find the routes, so the path prefixes of any nested (Route/Group) and mounted (Mount) routers are followed.
+// It will individually call handlerroute.CreateRoute for each route.
+func CreateRoutes(router chi.Routes) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Msg("Populating service with chi routes")
+
+		routes := make([]walkedRoute, 0)
+		err := chi.Walk(router, func(method string, route string, handler http.Handler, _ ...func(http.Handler) http.Handler) error {
+			routes = append(routes, walkedRoute{
+				method:  method,
+				path:    route,
+				handler: handler,
+			})
+			return nil
+		})
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to walk chi routes")
+			return err
+		}
+
+		// The handlers for each route are stored in a map, so we sort the routes to keep the output consistent.
+		sort.SliceStable(routes, func(i, j int) bool {
+			if routes[i].path == routes[j].path {
+				return routes[i].method < routes[j].method
+			}
+			return routes[i].path < routes[j].path
+		})
+
+		locator := utils.NewHandlerLocator(s.WorkDir)
+		for _, route := range routes {
+			s.Log.Debug().Str("path", route.path).Str("method", route.method).Msg("Populating route")
+
+			denied := false
+			for _, denyFunc := range s.PathDenyList {
+				if denyFunc(route.path) {
+					s.Log.Debug().Str("path", route.path).Str("method", route.method).Msg("Path is blacklisted")
+					denied = true
+					break
+				}
+			}
+			if denied {
+				continue
+			}
+
+			// Routes with inline middlewares (i.e. r.With) are wrapped in a chain handler, so the endpoint is used instead.
+			if chainHandler, ok := route.handler.(*chi.ChainHandler); ok {
+				route.handler = chainHandler.Endpoint
+			}
+
+			// Only function handlers (i.e. http.HandlerFunc) can be located, handlers implementing http.Handler with a struct are skipped.
+			handlerValue := reflect.ValueOf(route.handler)
+			if handlerValue.Kind() != reflect.Func {
+				s.Log.Warn().Str("path", route.path).Str("method", route.method).Str("handlerType", handlerValue.Type().String()).Msg("Handler is not a function, skipping")
+				continue
+			}
+
+			pc := handlerValue.Pointer()
+			handlerFunc := runtime.FuncForPC(pc)
+			file, line := handlerFunc.FileLine(pc)
+
+			// A method value is wrapped in a function that the compiler generates, so it has no position of its own
+			if utils.SplitHandlerPath(handlerFunc.Name()).Receiver() != "" {
+				file, line, err = locator.Find(handlerFunc.Name())
+				if err != nil {
+					s.Log.Error().Str("path", route.path).Str("method", route.method).Str("handler", handlerFunc.Name()).Err(err).Msg("Failed to find route handler")
+					return err
+				}
+			}
+
+			s.Log.Debug().Str("path", route.path).Str("method", route.method).Str("file", file).Int("line", line).Msg("Found route handler")
+
+			s.Log.Debug().Str("path", route.path).Str("method", route.method).Str("file", file).Int("line", line).Msg("Parsing route")
+			err = handlerroute.CreateRoute(s, file, line, route.method, route.path, handlerFunc.Name())
+			if err != nil {
+				s.Log.Error().Str("path", route.path).Str("method", route.method).Str("file", file).Int("line", line).Err(err).Msg("Failed to parse route")
+				return err
+			}
+		}
+		s.Log.Debug().Msg("Populated service with chi routes")
+
+		return nil
+	}
+}
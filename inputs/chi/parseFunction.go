@@ -0,0 +1,48 @@
+package chi
+
+import (
+	"go/types"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/inputs/internal/httphandler"
+)
+
+const (
+	// ChiPackagePath is the import path of the chi package.
+	ChiPackagePath = "github.com/go-chi/chi/v5"
+	// HTTPPackagePath is the import path of the net/http package.
+	HTTPPackagePath = httphandler.HTTPPackagePath
+	// JSONPackagePath is the import path of the encoding/json package.
+	JSONPackagePath = httphandler.JSONPackagePath
+	// ResponseWriterType is the type of the response writer variable.
+	ResponseWriterType = httphandler.ResponseWriterType
+	// ResponseWriterIsPointer is whether the response writer variable is a pointer for the handler functions.
+	ResponseWriterIsPointer = httphandler.ResponseWriterIsPointer
+	// RequestType is the type of the request variable.
+	RequestType = httphandler.RequestType
+	// RequestIsPointer is whether the request variable is a pointer for the handler functions.
+	RequestIsPointer = httphandler.RequestIsPointer
+)
+
+// router reads the path params from chi.URLParam, which takes the request and then the name of the path param.
+var router = httphandler.Router{
+	PackagePath: ChiPackagePath,
+	PathParamArg: func(funcType *types.Func, signature *types.Signature) (int, bool) {
+		return 1, funcType.Pkg() != nil && funcType.Pkg().Path() == ChiPackagePath && signature.Recv() == nil && funcType.Name() == "URLParam"
+	},
+}
+
+// ParseRoutes parses routes from chi routes.
+// It will populate the routes with the handler function, which is parsed by parseFunction.
+// CreateRoutes must be called before this.
+func ParseRoutes() astra.ServiceFunction {
+	return handlerroute.ParseRoutes("chi", parseFunction)
+}
+
+// parseFunction parses a function and adds it to the service.
+// The handlers of chi are net/http handlers, so they are parsed in the same way, with the path params from chi.URLParam.
+func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error {
+	return httphandler.ParseFunction(s, funcTraverser, currRoute, activeFile, level, router)
+}
@@ -0,0 +1,60 @@
+package echo
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateRoutes creates routes from an echo instance.
+// It will only create the routes and refer to the handler function by name, file and line number.
+// The routes will be populated later by parseRoutes.
+// It will individually call handlerroute.CreateRoute for each route.
+// Echo only keeps the name of the handler, so a route that has been renamed (i.e. e.GET(...).Name = "create-pet") can't be found and is skipped with a warning.
+func CreateRoutes(e *echo.Echo) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Msg("Populating service with echo routes")
+		locator := utils.NewHandlerLocator(s.WorkDir)
+		for _, route := range e.Routes() {
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
+
+			// The "route not found" handlers are registered as routes with a special method, they aren't endpoints.
+			if route.Method == echo.RouteNotFound {
+				continue
+			}
+
+			denied := false
+			for _, denyFunc := range s.PathDenyList {
+				if denyFunc(route.Path) {
+					s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Path is blacklisted")
+					denied = true
+					break
+				}
+			}
+			if denied {
+				continue
+			}
+
+			// The route name is the handler name, unless the route has been renamed, in which case the handler can't be found
+			file, line, err := locator.Find(route.Name)
+			if err != nil {
+				s.Log.Warn().Str("path", route.Path).Str("method", route.Method).Str("handler", route.Name).Err(err).Msg("Failed to find route handler, the route will be skipped")
+				continue
+			}
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Found route handler")
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Parsing route")
+			err = handlerroute.CreateRoute(s, file, line, route.Method, route.Path, route.Name)
+			if err != nil {
+				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Err(err).Msg("Failed to parse route")
+				return err
+			}
+		}
+		s.Log.Debug().Msg("Populated service with echo routes")
+
+		return nil
+	}
+}
@@ -0,0 +1,579 @@
+package echo
+
+import (
+	"errors"
+	"go/ast"
+	"go/types"
+	"net/http"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+)
+
+const (
+	// EchoPackagePath is the import path of the echo package.
+	EchoPackagePath = "github.com/labstack/echo/v4"
+	// EchoContextType is the type of the context variable.
+	EchoContextType = "Context"
+	// EchoContextIsPointer is whether the context variable is a pointer for the handler functions.
+	EchoContextIsPointer = false
+	// HTTPHeaderType is the type used by the request and response headers.
+	HTTPHeaderType = "net/http.Header"
+)
+
+// ParseRoutes parses routes from echo routes.
+// It will populate the routes with the handler function, which is parsed by parseFunction.
+// CreateRoutes must be called before this.
+func ParseRoutes() astra.ServiceFunction {
+	return handlerroute.ParseRoutes("echo", parseFunction)
+}
+
+// parseFunction parses a function and adds it to the service.
+// It is designed to be called recursively should it be required.
+// The level parameter is used to determine the depth of recursion.
+// And the package name and path are used to determine the package of the currently analysed function.
+// The currRoute reference is used to manipulate the current route being analysed.
+// The imports are used to determine the package of the context variable.
+func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error {
+	traverser := funcTraverser.Traverser
+
+	traverser.SetActiveFile(activeFile)
+	traverser.SetAddComponentFunction(addComponent(s, currRoute))
+
+	if level == 0 {
+		funcDoc, err := funcTraverser.Doc()
+		if err != nil {
+			return err
+		}
+		if funcDoc != "" {
+			var docMetadata astTraversal.DocMetadata
+			currRoute.Doc, docMetadata = astTraversal.ParseDoc(funcDoc)
+			currRoute.Deprecated = docMetadata.Deprecated
+		}
+	}
+
+	ctxName := funcTraverser.FindArgumentNameByType(EchoContextType, EchoPackagePath, EchoContextIsPointer)
+	if ctxName == "" {
+		return errors.New("failed to find context variable name")
+	}
+
+	var err error
+	// Loop over every statement in the function
+	ast.Inspect(funcTraverser.Node.Body, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+		// If a function is called
+		var callExpr *astTraversal.CallExpressionTraverser
+		callExpr, err = traverser.CallExpression(n)
+		if errors.Is(err, astTraversal.ErrInvalidNodeType) {
+			err = nil
+			return true
+		} else if err != nil {
+			return true
+		}
+
+		funcBuilder := astra.NewContextFuncBuilder(currRoute, callExpr)
+
+		// Loop over every custom function
+		// If the custom function returns a route, use that route instead of the current route
+		// And break out of this AST traversal for this call expression
+		// Otherwise, continue on
+		var shouldBreak bool
+		for _, customFunc := range s.CustomFuncs {
+			var newRoute *astra.Route
+			newRoute, err = customFunc(ctxName, funcBuilder)
+			if err != nil {
+				return false
+			}
+			if newRoute != nil {
+				currRoute = newRoute
+				shouldBreak = true
+				break
+			}
+		}
+		if shouldBreak {
+			return true
+		}
+
+		// If the function takes the context as any argument, traverse it
+		_, ok := callExpr.ArgIndex(ctxName)
+		if ok {
+			var function *astTraversal.FunctionTraverser
+			function, err = callExpr.Function()
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("failed to get function")
+				return false
+			}
+
+			err = parseFunction(s, function, currRoute, function.Traverser.ActiveFile(), level+1)
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("error parsing function")
+				return false
+			}
+
+			traverser.SetActiveFile(activeFile)
+		} else {
+			var funcType *types.Func
+			funcType, err = callExpr.Type()
+			// Built in functions and type conversions (i.e. []byte("ok")) aren't functions that can be parsed
+			if errors.Is(err, astTraversal.ErrBuiltInFunction) || errors.Is(err, astTraversal.ErrInvalidNodeType) {
+				err = nil
+				return true
+			} else if err != nil {
+				return false
+			}
+
+			signature, ok := funcType.Type().(*types.Signature)
+			if !ok {
+				traverser.Log.Error().Err(err).Msg("error getting function signature")
+				return false
+			}
+
+			signaturePath := EchoPackagePath + "." + EchoContextType
+			if EchoContextIsPointer {
+				signaturePath = "*" + signaturePath
+			}
+
+			if signature.Recv() == nil {
+				// Errors returned from the handler are written by echo's default error handler
+				if funcType.Pkg() != nil && funcType.Pkg().Path() == EchoPackagePath && funcType.Name() == "NewHTTPError" {
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						errorType, err := callExpr.ReturnType(0)
+						if err != nil {
+							return nil, err
+						}
+
+						result, err := traverser.Type(errorType, callExpr.File.Package).Result()
+						if err != nil {
+							return nil, err
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/json",
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				}
+			} else if signature.Recv().Type().String() == HTTPHeaderType {
+				// Headers are accessed through the underlying request and response, i.e. c.Request().Header.Get and c.Response().Header().Set
+				accessor, ok := contextAccessor(callExpr.Node.Fun, ctxName)
+				if !ok {
+					return true
+				}
+
+				switch {
+				case accessor == "Request" && funcType.Name() == "Get":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.RequestHeaders = append(route.RequestHeaders, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case accessor == "Response" && (funcType.Name() == "Set" || funcType.Name() == "Add"):
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.ResponseHeaders = append(route.ResponseHeaders, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				}
+			} else if signature.Recv().Type().String() == signaturePath {
+				switch funcType.Name() {
+				case "JSON", "JSONPretty":
+					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						result, ok := params[1].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/json",
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "JSONP":
+					currRoute, err = funcBuilder.StatusCode().Ignored().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						result, ok := params[2].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/javascript",
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "XML", "XMLPretty":
+					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						result, ok := params[1].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/xml",
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "JSONBlob", "XMLBlob", "HTML", "HTMLBlob", "String":
+					contentTypes := map[string]string{
+						"JSONBlob": "application/json",
+						"XMLBlob":  "application/xml",
+						"HTML":     "text/html",
+						"HTMLBlob": "text/html",
+						"String":   "text/plain",
+					}
+					contentType := contentTypes[funcType.Name()]
+
+					currRoute, err = funcBuilder.StatusCode().Ignored().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						// The blob functions take raw bytes, so we can't infer anything about the shape of the content
+						field := astra.Field{
+							Type: "any",
+						}
+						if contentType == "text/html" || contentType == "text/plain" {
+							field.Type = "string"
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: contentType,
+							Field:       field,
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "Blob", "Stream":
+					currRoute, err = funcBuilder.StatusCode().Value().Ignored().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						contentType, ok := params[1].(string)
+						if !ok {
+							return nil, errors.New("failed to parse content type")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: contentType,
+							Field: astra.Field{
+								Type: "file",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "File", "Attachment", "Inline":
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						returnType := astra.ReturnType{
+							StatusCode:  http.StatusOK,
+							ContentType: "application/octet-stream",
+							Field: astra.Field{
+								Type: "file",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "NoContent", "Redirect":
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode: statusCode,
+							Field: astra.Field{
+								Type: "nil",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				// Path Param methods
+				case "Param":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						// The path params are extracted from the path, so we only add params that aren't named in it (e.g. the "*" wildcard)
+						for _, pathParam := range route.PathParams {
+							if pathParam.Name == name {
+								return route, nil
+							}
+						}
+
+						route.PathParams = append(route.PathParams, astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name:       name,
+							IsRequired: true,
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				// Query Param methods
+				case "QueryParam":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.QueryParams = append(route.QueryParams, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+				// Body Param methods
+				case "Bind":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						field := astra.ParseResultToField(result)
+
+						route.PathParams = append(route.PathParams, astra.Param{
+							IsBound: true,
+							Field:   field,
+						})
+
+						route.QueryParams = append(route.QueryParams, astra.Param{
+							IsBound: true,
+							Field:   field,
+						})
+
+						for _, bodyBindingTag := range []astTraversal.BindingTagType{astTraversal.FormBindingTag, astTraversal.JSONBindingTag, astTraversal.XMLBindingTag} {
+							contentTypes := astra.BindingTagToContentTypes(bodyBindingTag)
+
+							for _, contentType := range contentTypes {
+								route.Body = append(route.Body, astra.BodyParam{
+									ContentType: contentType,
+									IsBound:     true,
+									Field:       field,
+								})
+							}
+						}
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "FormValue":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.BodyParam{
+							ContentType: "application/x-www-form-urlencoded",
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.Body = append(route.Body, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "FormFile":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.BodyParam{
+							ContentType: "multipart/form-data",
+							Field: astra.Field{
+								Type: "file",
+							},
+							Name: name,
+						}
+
+						route.Body = append(route.Body, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(currRoute.ReturnTypes) == 0 && level == 0 {
+		return errors.New("return type not found")
+	}
+
+	return nil
+}
+
+// contextAccessor finds the context method at the root of a chain of calls and selectors.
+// For example, c.Request().Header.Get would return "Request" if c is the context variable.
+func contextAccessor(expr ast.Expr, ctxName string) (string, bool) {
+	for {
+		switch e := expr.(type) {
+		case *ast.CallExpr:
+			expr = e.Fun
+		case *ast.SelectorExpr:
+			if ident, ok := e.X.(*ast.Ident); ok && ident.Name == ctxName {
+				return e.Sel.Name, true
+			}
+			expr = e.X
+		default:
+			return "", false
+		}
+	}
+}
+
+func addComponent(s *astra.Service, currRoute *astra.Route) func(astTraversal.Result) error {
+	return func(result astTraversal.Result) error {
+		field := astra.ParseResultToField(result)
+
+		if field.Package != "" {
+			s.AddComponent(currRoute, field)
+		}
+		return nil
+	}
+}
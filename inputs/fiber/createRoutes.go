@@ -0,0 +1,86 @@
+package fiber
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateRoutes creates routes from a fiber app.
+// It will only create the routes and refer to the handler function by name, file and line number.
+// The routes will be populated later by parseRoutes.
+// Middlewares registered with Use are skipped, and the last handler of each route is used as the handler function.
+// It will individually call handlerroute.CreateRoute for each route.
+func CreateRoutes(app *fiber.App) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Msg("Populating service with fiber routes")
+
+		routes := app.GetRoutes(true)
+
+		// Fiber registers a HEAD route for every GET route, so they are skipped to avoid documenting them twice.
+		getRoutes := make(map[string]bool)
+		for _, route := range routes {
+			if route.Method == http.MethodGet {
+				getRoutes[route.Path] = true
+			}
+		}
+
+		locator := utils.NewHandlerLocator(s.WorkDir)
+		for _, route := range routes {
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
+
+			if route.Method == http.MethodHead && getRoutes[route.Path] {
+				s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Skipping HEAD route registered with GET route")
+				continue
+			}
+
+			if len(route.Handlers) == 0 {
+				continue
+			}
+
+			denied := false
+			for _, denyFunc := range s.PathDenyList {
+				if denyFunc(route.Path) {
+					s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Path is blacklisted")
+					denied = true
+					break
+				}
+			}
+			if denied {
+				continue
+			}
+
+			pc := reflect.ValueOf(route.Handlers[len(route.Handlers)-1]).Pointer()
+			handlerFunc := runtime.FuncForPC(pc)
+			file, line := handlerFunc.FileLine(pc)
+
+			// A method value is wrapped in a function that the compiler generates, so it has no position of its own
+			if utils.SplitHandlerPath(handlerFunc.Name()).Receiver() != "" {
+				var err error
+				file, line, err = locator.Find(handlerFunc.Name())
+				if err != nil {
+					s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("handler", handlerFunc.Name()).Err(err).Msg("Failed to find route handler")
+					return err
+				}
+			}
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Found route handler")
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Parsing route")
+			err := handlerroute.CreateRoute(s, file, line, route.Method, route.Path, handlerFunc.Name())
+			if err != nil {
+				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Err(err).Msg("Failed to parse route")
+				return err
+			}
+		}
+		s.Log.Debug().Msg("Populated service with fiber routes")
+
+		return nil
+	}
+}
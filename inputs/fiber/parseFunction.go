@@ -0,0 +1,653 @@
+package fiber
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"net/http"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+)
+
+const (
+	// FiberPackagePath is the import path of the fiber package.
+	FiberPackagePath = "github.com/gofiber/fiber/v2"
+	// FiberContextType is the type of the context variable.
+	FiberContextType = "Ctx"
+	// FiberContextIsPointer is whether the context variable is a pointer for the handler functions.
+	FiberContextIsPointer = true
+)
+
+// ParseRoutes parses routes from fiber routes.
+// It will populate the routes with the handler function, which is parsed by parseFunction.
+// CreateRoutes must be called before this.
+func ParseRoutes() astra.ServiceFunction {
+	return handlerroute.ParseRoutes("fiber", parseFunction)
+}
+
+// parseFunction parses a function and adds it to the service.
+// It is designed to be called recursively should it be required.
+// The level parameter is used to determine the depth of recursion.
+// And the package name and path are used to determine the package of the currently analysed function.
+// The currRoute reference is used to manipulate the current route being analysed.
+// The imports are used to determine the package of the context variable.
+func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error {
+	traverser := funcTraverser.Traverser
+
+	traverser.SetActiveFile(activeFile)
+	traverser.SetAddComponentFunction(addComponent(s, currRoute))
+
+	if level == 0 {
+		funcDoc, err := funcTraverser.Doc()
+		if err != nil {
+			return err
+		}
+		if funcDoc != "" {
+			var docMetadata astTraversal.DocMetadata
+			currRoute.Doc, docMetadata = astTraversal.ParseDoc(funcDoc)
+			currRoute.Deprecated = docMetadata.Deprecated
+		}
+	}
+
+	ctxName := funcTraverser.FindArgumentNameByType(FiberContextType, FiberPackagePath, FiberContextIsPointer)
+	if ctxName == "" {
+		return errors.New("failed to find context variable name")
+	}
+
+	signaturePath := FiberPackagePath + "." + FiberContextType
+	if FiberContextIsPointer {
+		signaturePath = "*" + signaturePath
+	}
+
+	// statusCode is the status code set by the last call to c.Status that isn't chained to a response method.
+	// The responses written after it in the same block will be documented with that status code (or 200 if it was never set).
+	var statusCode int
+	var statusBlockEnd token.Pos
+	// chainedStatusCalls are the c.Status calls that have already been used by the response method chained to them, i.e. c.Status(400).JSON(...).
+	chainedStatusCalls := make(map[ast.Node]bool)
+
+	// responseStatusCode finds the status code of a response method call.
+	// It uses the chained c.Status call if there is one, otherwise the status code set previously in the function.
+	responseStatusCode := func(callExpr *astTraversal.CallExpressionTraverser) (int, error) {
+		selectorExpr, ok := callExpr.Node.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return 0, errors.New("failed to parse response method")
+		}
+
+		if !isStatusCall(selectorExpr.X) {
+			if statusCode == 0 || callExpr.Node.Pos() > statusBlockEnd {
+				return http.StatusOK, nil
+			}
+
+			return statusCode, nil
+		}
+
+		statusCallExpr, err := traverser.CallExpression(selectorExpr.X)
+		if err != nil {
+			return 0, err
+		}
+
+		chainedStatusCalls[statusCallExpr.Node] = true
+
+		var chainedStatusCode int
+		_, err = astra.NewContextFuncBuilder(currRoute, statusCallExpr).StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+			var ok bool
+			chainedStatusCode, ok = params[0].(int)
+			if !ok {
+				return nil, errors.New("failed to parse status code")
+			}
+
+			return route, nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return chainedStatusCode, nil
+	}
+
+	var err error
+	// Loop over every statement in the function
+	ast.Inspect(funcTraverser.Node.Body, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+		// If a function is called
+		var callExpr *astTraversal.CallExpressionTraverser
+		callExpr, err = traverser.CallExpression(n)
+		if errors.Is(err, astTraversal.ErrInvalidNodeType) {
+			err = nil
+			return true
+		} else if err != nil {
+			return true
+		}
+
+		funcBuilder := astra.NewContextFuncBuilder(currRoute, callExpr)
+
+		// Loop over every custom function
+		// If the custom function returns a route, use that route instead of the current route
+		// And break out of this AST traversal for this call expression
+		// Otherwise, continue on
+		var shouldBreak bool
+		for _, customFunc := range s.CustomFuncs {
+			var newRoute *astra.Route
+			newRoute, err = customFunc(ctxName, funcBuilder)
+			if err != nil {
+				return false
+			}
+			if newRoute != nil {
+				currRoute = newRoute
+				shouldBreak = true
+				break
+			}
+		}
+		if shouldBreak {
+			return true
+		}
+
+		// If the function takes the context as any argument, traverse it
+		_, ok := callExpr.ArgIndex(ctxName)
+		if ok {
+			var function *astTraversal.FunctionTraverser
+			function, err = callExpr.Function()
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("failed to get function")
+				return false
+			}
+
+			err = parseFunction(s, function, currRoute, function.Traverser.ActiveFile(), level+1)
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("error parsing function")
+				return false
+			}
+
+			traverser.SetActiveFile(activeFile)
+		} else {
+			var funcType *types.Func
+			funcType, err = callExpr.Type()
+			// Built in functions and type conversions (i.e. []byte("ok")) aren't functions that can be parsed
+			if errors.Is(err, astTraversal.ErrBuiltInFunction) || errors.Is(err, astTraversal.ErrInvalidNodeType) {
+				err = nil
+				return true
+			} else if err != nil {
+				return false
+			}
+
+			signature, ok := funcType.Type().(*types.Signature)
+			if !ok {
+				traverser.Log.Error().Err(err).Msg("error getting function signature")
+				return false
+			}
+
+			if signature.Recv() == nil {
+				// Errors returned from the handler are written by fiber's default error handler as plain text
+				if funcType.Pkg() != nil && funcType.Pkg().Path() == FiberPackagePath && funcType.Name() == "NewError" {
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "text/plain",
+							Field: astra.Field{
+								Type: "string",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				}
+			} else if signature.Recv().Type().String() == signaturePath {
+				switch funcType.Name() {
+				// Status methods
+				case "Status":
+					if chainedStatusCalls[callExpr.Node] {
+						return true
+					}
+
+					_, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						var ok bool
+						statusCode, ok = params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+					statusBlockEnd = enclosingBlockEnd(funcTraverser.Node.Body, callExpr.Node.Pos())
+
+				// Response methods
+				case "JSON", "JSONP", "XML":
+					contentTypes := map[string]string{
+						"JSON":  "application/json",
+						"JSONP": "application/javascript",
+						"XML":   "application/xml",
+					}
+					contentType := contentTypes[funcType.Name()]
+
+					var responseStatus int
+					responseStatus, err = responseStatusCode(callExpr)
+					if err != nil {
+						return false
+					}
+
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  responseStatus,
+							ContentType: contentType,
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "SendString", "Send":
+					var responseStatus int
+					responseStatus, err = responseStatusCode(callExpr)
+					if err != nil {
+						return false
+					}
+
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						returnType := astra.ReturnType{
+							StatusCode:  responseStatus,
+							ContentType: "text/plain",
+							Field: astra.Field{
+								Type: "string",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "SendFile", "Download":
+					var responseStatus int
+					responseStatus, err = responseStatusCode(callExpr)
+					if err != nil {
+						return false
+					}
+
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						returnType := astra.ReturnType{
+							StatusCode:  responseStatus,
+							ContentType: "application/octet-stream",
+							Field: astra.Field{
+								Type: "file",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "SendStatus":
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode: statusCode,
+							Field: astra.Field{
+								Type: "nil",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "Redirect":
+					// The status code is optional, and defaults to 302
+					if len(callExpr.Node.Args) > 1 {
+						funcBuilder = funcBuilder.Ignored().StatusCode()
+					}
+
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode := http.StatusFound
+						if len(params) > 1 {
+							var ok bool
+							statusCode, ok = params[1].(int)
+							if !ok {
+								return nil, errors.New("failed to parse status code")
+							}
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode: statusCode,
+							Field: astra.Field{
+								Type: "nil",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+				// Path Param methods
+				case "Params", "ParamsInt":
+					paramTypes := map[string]string{
+						"Params":    "string",
+						"ParamsInt": "int",
+					}
+					paramType := paramTypes[funcType.Name()]
+
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						// The path params are extracted from the path, so we only add params that aren't named in it (e.g. the "*" wildcard)
+						for _, pathParam := range route.PathParams {
+							if pathParam.Name == name {
+								return route, nil
+							}
+						}
+
+						route.PathParams = append(route.PathParams, astra.Param{
+							Field: astra.Field{
+								Type: paramType,
+							},
+							Name:       name,
+							IsRequired: true,
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "ParamsParser":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						route.PathParams = append(route.PathParams, astra.Param{
+							IsBound: true,
+							Field:   astra.ParseResultToField(result),
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+				// Query Param methods
+				case "Query", "QueryInt", "QueryBool", "QueryFloat":
+					paramTypes := map[string]string{
+						"Query":      "string",
+						"QueryInt":   "int",
+						"QueryBool":  "bool",
+						"QueryFloat": "float64",
+					}
+					paramType := paramTypes[funcType.Name()]
+
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: paramType,
+							},
+							Name: name,
+						}
+
+						route.QueryParams = append(route.QueryParams, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "QueryParser":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						route.QueryParams = append(route.QueryParams, astra.Param{
+							IsBound: true,
+							Field:   astra.ParseResultToField(result),
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+				// Header methods
+				case "Get":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.RequestHeaders = append(route.RequestHeaders, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "ReqHeaderParser":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						route.RequestHeaders = append(route.RequestHeaders, astra.Param{
+							IsBound: true,
+							Field:   astra.ParseResultToField(result),
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "Set", "Append":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.ResponseHeaders = append(route.ResponseHeaders, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
+				// Body Param methods
+				case "BodyParser":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						field := astra.ParseResultToField(result)
+
+						for _, bodyBindingTag := range []astTraversal.BindingTagType{astTraversal.FormBindingTag, astTraversal.JSONBindingTag, astTraversal.XMLBindingTag} {
+							contentTypes := astra.BindingTagToContentTypes(bodyBindingTag)
+
+							for _, contentType := range contentTypes {
+								route.Body = append(route.Body, astra.BodyParam{
+									ContentType: contentType,
+									IsBound:     true,
+									Field:       field,
+								})
+							}
+						}
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "FormValue":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.BodyParam{
+							ContentType: "application/x-www-form-urlencoded",
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.Body = append(route.Body, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "FormFile":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						param := astra.BodyParam{
+							ContentType: "multipart/form-data",
+							Field: astra.Field{
+								Type: "file",
+							},
+							Name: name,
+						}
+
+						route.Body = append(route.Body, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(currRoute.ReturnTypes) == 0 && level == 0 {
+		return errors.New("return type not found")
+	}
+
+	return nil
+}
+
+// enclosingBlockEnd finds the end of the innermost block that contains the position.
+func enclosingBlockEnd(body *ast.BlockStmt, pos token.Pos) token.Pos {
+	end := body.End()
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false
+		}
+
+		if block, ok := n.(*ast.BlockStmt); ok {
+			end = block.End()
+		}
+
+		return true
+	})
+
+	return end
+}
+
+// isStatusCall checks whether the expression is a call to a Status method, i.e. the c.Status(400) in c.Status(400).JSON(...).
+func isStatusCall(expr ast.Expr) bool {
+	callExpr, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	return ok && selectorExpr.Sel.Name == "Status" && len(callExpr.Args) == 1
+}
+
+func addComponent(s *astra.Service, currRoute *astra.Route) func(astTraversal.Result) error {
+	return func(result astTraversal.Result) error {
+		field := astra.ParseResultToField(result)
+
+		if field.Package != "" {
+			s.AddComponent(currRoute, field)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package gin
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// GinBindingPackagePath is the import path of the binding package of gin.
+const GinBindingPackagePath = "github.com/gin-gonic/gin/binding"
+
+// bindingEngineTags are the binding tags of the bodies that the binding engines of gin bind, by the name of their variable in the binding package.
+// The engines that bind the query or headers (binding.Query and binding.Header) don't bind a body.
+var bindingEngineTags = map[string]astTraversal.BindingTagType{
+	"JSON":          astTraversal.JSONBindingTag,
+	"XML":           astTraversal.XMLBindingTag,
+	"YAML":          astTraversal.YAMLBindingTag,
+	"TOML":          astTraversal.TOMLBindingTag,
+	"ProtoBuf":      astTraversal.ProtoBufBindingTag,
+	"MsgPack":       astTraversal.MsgPackBindingTag,
+	"Form":          astTraversal.FormBindingTag,
+	"FormPost":      astTraversal.FormBindingTag,
+	"FormMultipart": astTraversal.FormBindingTag,
+}
+
+// formEngineContentTypes are the form engines that only bind one of the content types of a form.
+var formEngineContentTypes = map[string]string{
+	"FormPost":      binding.MIMEPOSTForm,
+	"FormMultipart": binding.MIMEMultipartPOSTForm,
+}
+
+// bindingEngine finds the name of the binding engine of gin that an expression refers to (i.e. JSON for binding.JSON).
+// It isn't found if the expression isn't one of the variables of the binding package, such as a custom binding.
+func bindingEngine(info *types.Info, expr ast.Expr) (string, bool) {
+	var ident *ast.Ident
+	switch node := expr.(type) {
+	case *ast.SelectorExpr:
+		ident = node.Sel
+	case *ast.Ident:
+		ident = node
+	default:
+		return "", false
+	}
+
+	obj, ok := info.Uses[ident].(*types.Var)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != GinBindingPackagePath {
+		return "", false
+	}
+
+	return obj.Name(), true
+}
+
+// bindMethodEngine finds the name of the binding engine that a bind method of the context uses (i.e. JSON for ShouldBindJSON, BindJSON and ShouldBindBodyWithJSON).
+func bindMethodEngine(methodName string) string {
+	for _, prefix := range []string{"ShouldBindBodyWith", "ShouldBind", "Bind"} {
+		if engine, ok := strings.CutPrefix(methodName, prefix); ok {
+			return engine
+		}
+	}
+
+	return methodName
+}
+
+// addBoundField adds a field that is bound by a binding engine of gin to the route.
+// The query and header engines bind the query params and request headers, and the rest bind the body with the content types of their binding tag.
+func addBoundField(route *astra.Route, engine string, field astra.Field) *astra.Route {
+	switch engine {
+	case "Query":
+		route.QueryParams = append(route.QueryParams, astra.Param{
+			IsBound: true,
+			Field:   field,
+		})
+
+		return route
+	case "Header":
+		route.RequestHeaders = append(route.RequestHeaders, astra.Param{
+			IsBound: true,
+			Field:   field,
+		})
+
+		return route
+	}
+
+	contentTypes := astra.BindingTagToContentTypes(bindingEngineTags[engine])
+	if contentType, ok := formEngineContentTypes[engine]; ok && slices.Contains(contentTypes, contentType) {
+		contentTypes = []string{contentType}
+	}
+
+	for _, contentType := range contentTypes {
+		route.Body = append(route.Body, astra.BodyParam{
+			ContentType: contentType,
+			IsBound:     true,
+			Field:       field,
+		})
+	}
+
+	return route
+}
@@ -0,0 +1,29 @@
+package gin
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// constantString finds the value of a constant string expression.
+func constantString(info *types.Info, expr ast.Expr) (string, error) {
+	value := info.Types[expr].Value
+	if value == nil || value.Kind() != constant.String {
+		return "", fmt.Errorf("expression is not a constant string: %s", types.ExprString(expr))
+	}
+
+	return constant.StringVal(value), nil
+}
+
+// stringValue finds the value of a string expression, which is either a constant or a variable that the traverser can follow to its value.
+func stringValue(traverser *astTraversal.BaseTraverser, info *types.Info, expr ast.Expr) (string, error) {
+	if value, err := constantString(info, expr); err == nil {
+		return value, nil
+	}
+
+	return traverser.Expression(expr).Value()
+}
@@ -0,0 +1,45 @@
+package gin
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/ls6-events/astra"
+)
+
+// setCookie finds the cookie that is set by a call to c.SetCookie, from the arguments that are constants.
+// The arguments are the name, value, max age, path, domain, secure and HTTP only attributes of the cookie, in that order.
+func setCookie(info *types.Info, args []ast.Expr) astra.Cookie {
+	var cookie astra.Cookie
+	if len(args) != 7 {
+		return cookie
+	}
+
+	cookie.Name, _ = constantString(info, args[0])
+	cookie.Domain, _ = constantString(info, args[4])
+
+	if path, err := constantString(info, args[3]); err == nil {
+		// gin sets the path of the cookie to the root if it's empty
+		if path == "" {
+			path = "/"
+		}
+
+		cookie.Path = path
+	}
+
+	if value := info.Types[args[2]].Value; value != nil && value.Kind() == constant.Int {
+		maxAge, _ := constant.Int64Val(value)
+		cookie.MaxAge = int(maxAge)
+	}
+
+	if value := info.Types[args[5]].Value; value != nil && value.Kind() == constant.Bool {
+		cookie.Secure = constant.BoolVal(value)
+	}
+
+	if value := info.Types[args[6]].Value; value != nil && value.Kind() == constant.Bool {
+		cookie.HTTPOnly = constant.BoolVal(value)
+	}
+
+	return cookie
+}
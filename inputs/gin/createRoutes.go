@@ -5,6 +5,8 @@ import (
 	"runtime"
 
 	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,10 +14,11 @@ import (
 // CreateRoutes creates routes from a gin routes.
 // It will only create the routes and refer to the handler function by name, file and line number.
 // The routes will be populated later by parseRoutes.
-// It will individually call createRoute for each route.
+// It will individually call handlerroute.CreateRoute for each route.
 func CreateRoutes(router *gin.Engine) astra.ServiceFunction {
 	return func(s *astra.Service) error {
 		s.Log.Debug().Msg("Populating service with gin routes")
+		locator := utils.NewHandlerLocator(s.WorkDir)
 		for _, route := range router.Routes() {
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
 
@@ -34,10 +37,20 @@ func CreateRoutes(router *gin.Engine) astra.ServiceFunction {
 			pc := reflect.ValueOf(route.HandlerFunc).Pointer()
 			file, line := runtime.FuncForPC(pc).FileLine(pc)
 
+			// A method value is wrapped in a function that the compiler generates, so it has no position of its own
+			if utils.SplitHandlerPath(route.Handler).Receiver() != "" {
+				var err error
+				file, line, err = locator.Find(route.Handler)
+				if err != nil {
+					s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("handler", route.Handler).Err(err).Msg("Failed to find route handler")
+					return err
+				}
+			}
+
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Found route handler")
 
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Parsing route")
-			err := createRoute(s, file, line, route)
+			err := handlerroute.CreateRoute(s, file, line, route.Method, route.Path, route.Handler)
 			if err != nil {
 				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Err(err).Msg("Failed to parse route")
 				return err
@@ -0,0 +1,584 @@
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/tools/go/packages"
+)
+
+// ginRootPath is the base path of a gin engine.
+const ginRootPath = "/"
+
+// ginAnyMethods are the methods registered by RouterGroup.Any.
+var ginAnyMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch,
+	http.MethodHead, http.MethodOptions, http.MethodDelete, http.MethodConnect,
+	http.MethodTrace,
+}
+
+// ginRouteMethods are the RouterGroup methods that register a route for a single HTTP method.
+var ginRouteMethods = map[string]string{
+	"GET":     http.MethodGet,
+	"POST":    http.MethodPost,
+	"PUT":     http.MethodPut,
+	"PATCH":   http.MethodPatch,
+	"DELETE":  http.MethodDelete,
+	"OPTIONS": http.MethodOptions,
+	"HEAD":    http.MethodHead,
+}
+
+// sourceExpr is an expression alongside the package it belongs to, as the type information is stored per package.
+type sourceExpr struct {
+	expr ast.Expr
+	pkg  *packages.Package
+}
+
+// sourceFunc is a function declaration alongside the package it belongs to.
+type sourceFunc struct {
+	decl *ast.FuncDecl
+	pkg  *packages.Package
+}
+
+// routeDiscoverer statically discovers gin routes from the source code of the packages.
+// Functions are keyed by their full name (i.e. github.com/org/repo/routes.Register), as the type information isn't shared between packages.
+type routeDiscoverer struct {
+	s    *astra.Service
+	fset *token.FileSet
+
+	// funcs are the function declarations by their full name.
+	funcs map[string]sourceFunc
+	// calls are the call expressions made to each function by its full name.
+	calls map[string][]*ast.CallExpr
+	// callPkgs are the packages of each of the call expressions.
+	callPkgs map[*ast.CallExpr]*packages.Package
+	// params are the function and parameter index for each parameter variable.
+	params map[types.Object]paramIndex
+	// assignments are the expressions assigned to each variable.
+	assignments map[types.Object][]sourceExpr
+
+	// resolving is the set of variables currently being resolved, to avoid infinite recursion.
+	resolving map[types.Object]bool
+}
+
+// paramIndex is the position of a parameter in a function.
+type paramIndex struct {
+	funcName string
+	index    int
+}
+
+// DiscoverRoutes creates routes by statically discovering them from the source code of the working directory (and all packages below it).
+// It is the alternative to CreateRoutes that doesn't need a live gin engine, so the routes can be found without running the program (i.e. in CI).
+// It finds the calls to the route methods (GET, POST, Handle, Any, Match, etc.) on a gin engine or router group, and follows the Group calls to resolve the path prefixes.
+// The router groups can be passed into functions, as the prefixes are resolved from each of the call sites.
+// The handlers can be function declarations, function literals or calls to functions that return a function literal.
+// It will individually call handlerroute.CreateRoute for each route.
+func DiscoverRoutes() astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Msg("Discovering gin routes from source")
+
+		fset := token.NewFileSet()
+		pkgs, err := packages.Load(&packages.Config{
+			Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+			Dir:  s.WorkDir,
+			Fset: fset,
+		}, "./...")
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to load packages")
+			return err
+		}
+
+		d := &routeDiscoverer{
+			s:           s,
+			fset:        fset,
+			funcs:       make(map[string]sourceFunc),
+			calls:       make(map[string][]*ast.CallExpr),
+			callPkgs:    make(map[*ast.CallExpr]*packages.Package),
+			params:      make(map[types.Object]paramIndex),
+			assignments: make(map[types.Object][]sourceExpr),
+			resolving:   make(map[types.Object]bool),
+		}
+
+		for _, pkg := range pkgs {
+			for _, pkgErr := range pkg.Errors {
+				s.Log.Warn().Str("package", pkg.PkgPath).Err(pkgErr).Msg("Package contains errors")
+			}
+
+			d.index(pkg)
+		}
+
+		routes := make([]discoveredRoute, 0)
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Syntax {
+				ast.Inspect(file, func(n ast.Node) bool {
+					callExpr, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+
+					found, err := d.routesFromCall(pkg, file, callExpr)
+					if err != nil {
+						s.Log.Warn().Str("position", fset.Position(callExpr.Pos()).String()).Err(err).Msg("Route could not be discovered, skipping")
+						return true
+					}
+
+					routes = append(routes, found...)
+
+					return true
+				})
+			}
+		}
+
+		// The packages aren't guaranteed to be loaded in a consistent order, so we sort the routes to keep the output consistent.
+		sort.SliceStable(routes, func(i, j int) bool {
+			if routes[i].info.Path == routes[j].info.Path {
+				return routes[i].info.Method < routes[j].info.Method
+			}
+			return routes[i].info.Path < routes[j].info.Path
+		})
+
+		for _, discovered := range routes {
+			route, position := discovered.info, discovered.position
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
+
+			denied := false
+			for _, denyFunc := range s.PathDenyList {
+				if denyFunc(route.Path) {
+					s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Path is blacklisted")
+					denied = true
+					break
+				}
+			}
+			if denied {
+				continue
+			}
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", position.Filename).Int("line", position.Line).Msg("Parsing route")
+			err := handlerroute.CreateRoute(s, position.Filename, position.Line, route.Method, route.Path, route.Handler)
+			if err != nil {
+				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", position.Filename).Int("line", position.Line).Err(err).Msg("Failed to parse route")
+				return err
+			}
+		}
+		s.Log.Debug().Msg("Discovered gin routes from source")
+
+		return nil
+	}
+}
+
+// index records the function declarations, function calls, parameters and variable assignments of a package.
+// These are used to resolve the path prefixes of the router groups.
+func (d *routeDiscoverer) index(pkg *packages.Package) {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			funcType, ok := pkg.TypesInfo.Defs[funcDecl.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			funcName := funcType.FullName()
+			d.funcs[funcName] = sourceFunc{decl: funcDecl, pkg: pkg}
+
+			index := 0
+			for _, field := range funcDecl.Type.Params.List {
+				if len(field.Names) == 0 {
+					index++
+					continue
+				}
+
+				for _, name := range field.Names {
+					if obj := pkg.TypesInfo.Defs[name]; obj != nil {
+						d.params[obj] = paramIndex{funcName: funcName, index: index}
+					}
+					index++
+				}
+			}
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if funcType := calledFunc(pkg.TypesInfo, node); funcType != nil {
+					funcName := funcType.FullName()
+					d.calls[funcName] = append(d.calls[funcName], node)
+					d.callPkgs[node] = pkg
+				}
+			case *ast.AssignStmt:
+				if len(node.Lhs) != len(node.Rhs) {
+					return true
+				}
+
+				for i, lhs := range node.Lhs {
+					ident, ok := lhs.(*ast.Ident)
+					if !ok {
+						continue
+					}
+
+					obj := pkg.TypesInfo.ObjectOf(ident)
+					if obj != nil {
+						d.assignments[obj] = append(d.assignments[obj], sourceExpr{expr: node.Rhs[i], pkg: pkg})
+					}
+				}
+			case *ast.ValueSpec:
+				if len(node.Names) != len(node.Values) {
+					return true
+				}
+
+				for i, name := range node.Names {
+					obj := pkg.TypesInfo.Defs[name]
+					if obj != nil {
+						d.assignments[obj] = append(d.assignments[obj], sourceExpr{expr: node.Values[i], pkg: pkg})
+					}
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+// discoveredRoute is a route found by the static discovery, alongside the position of its handler.
+type discoveredRoute struct {
+	info     gin.RouteInfo
+	position token.Position
+}
+
+// routesFromCall creates the routes registered by a call expression, if it is a call to one of the route methods of a gin router group.
+// A single call can register multiple routes, i.e. if the router group has multiple prefixes or Any/Match is used.
+func (d *routeDiscoverer) routesFromCall(pkg *packages.Package, file *ast.File, callExpr *ast.CallExpr) ([]discoveredRoute, error) {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok || !isRouterGroupMethod(pkg.TypesInfo, selectorExpr) {
+		return nil, nil
+	}
+
+	var methods []string
+	var args []ast.Expr
+	switch name := selectorExpr.Sel.Name; name {
+	case "Handle":
+		if len(callExpr.Args) < 2 {
+			return nil, nil
+		}
+
+		method, err := constantString(pkg.TypesInfo, callExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		methods = []string{method}
+		args = callExpr.Args[1:]
+	case "Any":
+		methods = ginAnyMethods
+		args = callExpr.Args
+	case "Match":
+		if len(callExpr.Args) < 2 {
+			return nil, nil
+		}
+
+		compositeLit, ok := callExpr.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return nil, errors.New("the methods passed to Match must be a slice literal")
+		}
+		for _, elt := range compositeLit.Elts {
+			method, err := constantString(pkg.TypesInfo, elt)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, method)
+		}
+		args = callExpr.Args[1:]
+	default:
+		method, ok := ginRouteMethods[name]
+		if !ok {
+			return nil, nil
+		}
+		methods = []string{method}
+		args = callExpr.Args
+	}
+
+	// There needs to be a path and at least one handler
+	if len(args) < 2 {
+		return nil, nil
+	}
+
+	relativePath, err := constantString(pkg.TypesInfo, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// The last handler is the route handler, the others are middlewares
+	handlerName, handlerPos, err := d.findHandler(pkg, file, args[len(args)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]discoveredRoute, 0)
+	for _, prefix := range d.resolvePrefixes(sourceExpr{expr: selectorExpr.X, pkg: pkg}) {
+		for _, method := range methods {
+			routes = append(routes, discoveredRoute{
+				info: gin.RouteInfo{
+					Method:  method,
+					Path:    joinPaths(prefix, relativePath),
+					Handler: handlerName,
+				},
+				position: d.fset.Position(handlerPos),
+			})
+		}
+	}
+
+	return routes, nil
+}
+
+// resolvePrefixes finds the possible base paths of a gin engine or router group expression.
+// Variables are resolved from their assignments, and function parameters are resolved from the arguments at each of the call sites.
+func (d *routeDiscoverer) resolvePrefixes(source sourceExpr) []string {
+	switch expr := source.expr.(type) {
+	case *ast.ParenExpr:
+		return d.resolvePrefixes(sourceExpr{expr: expr.X, pkg: source.pkg})
+	case *ast.UnaryExpr:
+		return d.resolvePrefixes(sourceExpr{expr: expr.X, pkg: source.pkg})
+	case *ast.StarExpr:
+		return d.resolvePrefixes(sourceExpr{expr: expr.X, pkg: source.pkg})
+	case *ast.CallExpr:
+		// A call to Group on a router group adds its relative path to each of the router group's prefixes
+		if selectorExpr, ok := expr.Fun.(*ast.SelectorExpr); ok && selectorExpr.Sel.Name == "Group" && isRouterGroupMethod(source.pkg.TypesInfo, selectorExpr) && len(expr.Args) > 0 {
+			relativePath, err := constantString(source.pkg.TypesInfo, expr.Args[0])
+			if err != nil {
+				d.s.Log.Warn().Str("position", d.fset.Position(expr.Pos()).String()).Err(err).Msg("Group path could not be resolved")
+				return nil
+			}
+
+			prefixes := make([]string, 0)
+			for _, prefix := range d.resolvePrefixes(sourceExpr{expr: selectorExpr.X, pkg: source.pkg}) {
+				prefixes = append(prefixes, joinPaths(prefix, relativePath))
+			}
+			return prefixes
+		}
+	case *ast.Ident:
+		obj := source.pkg.TypesInfo.ObjectOf(expr)
+		if obj == nil || d.resolving[obj] {
+			break
+		}
+
+		d.resolving[obj] = true
+		defer delete(d.resolving, obj)
+
+		// The prefixes of a function parameter are resolved from the arguments passed at each call site
+		if param, ok := d.params[obj]; ok {
+			prefixes := make([]string, 0)
+			for _, callExpr := range d.calls[param.funcName] {
+				if param.index >= len(callExpr.Args) {
+					continue
+				}
+
+				prefixes = append(prefixes, d.resolvePrefixes(sourceExpr{expr: callExpr.Args[param.index], pkg: d.callPkgs[callExpr]})...)
+			}
+			return uniquePrefixes(prefixes)
+		}
+
+		// The prefixes of a variable are resolved from the values assigned to it
+		if assignments, ok := d.assignments[obj]; ok {
+			prefixes := make([]string, 0)
+			for _, assignment := range assignments {
+				prefixes = append(prefixes, d.resolvePrefixes(assignment)...)
+			}
+			return uniquePrefixes(prefixes)
+		}
+	}
+
+	// Anything else (i.e. gin.New(), gin.Default() or a struct field) is assumed to be the root of a gin engine
+	if isGinType(source.pkg.TypesInfo.TypeOf(source.expr), "RouterGroup") {
+		d.s.Log.Warn().Str("position", d.fset.Position(source.expr.Pos()).String()).Msg("Router group could not be resolved, assuming it is the root of the engine")
+	}
+
+	return []string{ginRootPath}
+}
+
+// findHandler finds the name and position of the handler passed to a route method.
+// The name follows the same format as the runtime function names, so it can be used in the same way as CreateRoutes.
+func (d *routeDiscoverer) findHandler(pkg *packages.Package, file *ast.File, expr ast.Expr) (string, token.Pos, error) {
+	switch handler := expr.(type) {
+	case *ast.ParenExpr:
+		return d.findHandler(pkg, file, handler.X)
+	case *ast.FuncLit:
+		funcDecl := enclosingFuncDecl(file, handler.Pos())
+		if funcDecl == nil {
+			return "", token.NoPos, errors.New("function literal is not inside a function declaration")
+		}
+
+		return utils.ClosureName(runtimePkgPath(pkg), funcDecl, handler), handler.Pos(), nil
+	case *ast.Ident, *ast.SelectorExpr:
+		funcType, ok := referencedFunc(pkg.TypesInfo, handler)
+		if !ok {
+			return "", token.NoPos, fmt.Errorf("handler is not a function: %s", types.ExprString(handler))
+		}
+
+		// A method value (i.e. h.getPets) is found by the type of its receiver and its name, as the method declaration is indexed by its full name
+		source, ok := d.funcs[funcType.FullName()]
+		if !ok {
+			return "", token.NoPos, fmt.Errorf("could not find function declaration: %s", funcType.FullName())
+		}
+
+		if isMethod(funcType) {
+			return utils.MethodValueName(runtimePkgPath(source.pkg), funcType), source.decl.Pos(), nil
+		}
+
+		return runtimePkgPath(source.pkg) + "." + funcType.Name(), source.decl.Pos(), nil
+	case *ast.CallExpr:
+		// A function that returns the handler, i.e. func getPets() gin.HandlerFunc { return func(c *gin.Context) { ... } }
+		funcType := calledFunc(pkg.TypesInfo, handler)
+		if funcType == nil || isMethod(funcType) {
+			return "", token.NoPos, fmt.Errorf("unsupported handler expression: %s", types.ExprString(handler))
+		}
+
+		funcName := funcType.FullName()
+		source, ok := d.funcs[funcName]
+		if !ok {
+			return "", token.NoPos, fmt.Errorf("could not find function declaration: %s", funcName)
+		}
+
+		var funcLit *ast.FuncLit
+		ast.Inspect(source.decl.Body, func(n ast.Node) bool {
+			if funcLit != nil {
+				return false
+			}
+
+			if returnStmt, ok := n.(*ast.ReturnStmt); ok && len(returnStmt.Results) == 1 {
+				funcLit, _ = returnStmt.Results[0].(*ast.FuncLit)
+			}
+
+			return true
+		})
+		if funcLit == nil {
+			return "", token.NoPos, fmt.Errorf("could not find the returned handler function: %s", funcName)
+		}
+
+		return utils.ClosureName(runtimePkgPath(source.pkg), source.decl, funcLit), funcLit.Pos(), nil
+	}
+
+	return "", token.NoPos, fmt.Errorf("unsupported handler expression: %s", types.ExprString(expr))
+}
+
+// isRouterGroupMethod checks whether the selector is a method of a gin router group (or the engine, which embeds it).
+func isRouterGroupMethod(info *types.Info, selectorExpr *ast.SelectorExpr) bool {
+	selection, ok := info.Selections[selectorExpr]
+	if !ok || selection.Kind() != types.MethodVal {
+		return false
+	}
+
+	return isGinType(selection.Recv(), "RouterGroup") || isGinType(selection.Recv(), "Engine")
+}
+
+// isGinType checks whether the type is (a pointer to) the named gin type.
+func isGinType(t types.Type, name string) bool {
+	if t == nil {
+		return false
+	}
+
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == GinPackagePath && named.Obj().Name() == name
+}
+
+// calledFunc finds the function or method being called, or nil if it isn't a call to one.
+func calledFunc(info *types.Info, callExpr *ast.CallExpr) *types.Func {
+	funcType, ok := referencedFunc(info, callExpr.Fun)
+	if !ok {
+		return nil
+	}
+
+	return funcType
+}
+
+// isMethod checks whether the function has a receiver.
+func isMethod(funcType *types.Func) bool {
+	return funcType.Type().(*types.Signature).Recv() != nil
+}
+
+// runtimePkgPath returns the package path as it is used in the runtime function names, where the main package is always named main.
+func runtimePkgPath(pkg *packages.Package) string {
+	if pkg.Name == "main" {
+		return "main"
+	}
+
+	return pkg.PkgPath
+}
+
+// referencedFunc finds the function or method referenced by an identifier or selector.
+func referencedFunc(info *types.Info, expr ast.Expr) (*types.Func, bool) {
+	var ident *ast.Ident
+	switch e := expr.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return nil, false
+	}
+
+	funcType, ok := info.Uses[ident].(*types.Func)
+	return funcType, ok
+}
+
+// enclosingFuncDecl finds the function declaration that contains the position.
+func enclosingFuncDecl(file *ast.File, pos token.Pos) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if ok && funcDecl.Pos() <= pos && pos < funcDecl.End() {
+			return funcDecl
+		}
+	}
+
+	return nil
+}
+
+// joinPaths joins a base path and relative path in the same way as gin, keeping any trailing slash of the relative path.
+func joinPaths(absolutePath string, relativePath string) string {
+	if relativePath == "" {
+		return absolutePath
+	}
+
+	finalPath := path.Join(absolutePath, relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(finalPath, "/") {
+		return finalPath + "/"
+	}
+
+	return finalPath
+}
+
+// uniquePrefixes removes the duplicate prefixes, keeping the order they were found in.
+func uniquePrefixes(prefixes []string) []string {
+	seen := make(map[string]bool)
+	unique := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if !seen[prefix] {
+			seen[prefix] = true
+			unique = append(unique, prefix)
+		}
+	}
+
+	return unique
+}
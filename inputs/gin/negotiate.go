@@ -0,0 +1,114 @@
+package gin
+
+import (
+	"go/ast"
+	"net/http"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// negotiateFormat is a format that gin can negotiate, with the fields of gin.Negotiate that hold its data.
+type negotiateFormat struct {
+	contentType string
+	dataFields  []string
+}
+
+// negotiateFormats are the formats that gin can negotiate, by the MIME type that is offered.
+// The data of a format falls back to the Data field if its own field isn't set.
+var negotiateFormats = map[string]negotiateFormat{
+	"application/json":   {contentType: "application/json", dataFields: []string{"JSONData", "Data"}},
+	"text/html":          {contentType: "text/html"},
+	"application/xml":    {contentType: "application/xml", dataFields: []string{"XMLData", "Data"}},
+	"application/x-yaml": {contentType: "application/yaml", dataFields: []string{"YAMLData", "Data"}},
+	"application/toml":   {contentType: "application/toml", dataFields: []string{"TOMLData", "Data"}},
+}
+
+// negotiateReturnTypes finds the return types of a call to c.Negotiate, one for each of the offered formats.
+// The config has to be a gin.Negotiate literal, so the offered formats and their data can be found.
+// If none of the formats are accepted by the client, gin responds with 406 Not Acceptable.
+func negotiateReturnTypes(callExpr *astTraversal.CallExpressionTraverser, statusCode int, config ast.Expr) ([]astra.ReturnType, error) {
+	if unary, ok := config.(*ast.UnaryExpr); ok {
+		config = unary.X
+	}
+
+	compositeLit, ok := config.(*ast.CompositeLit)
+	if !ok {
+		callExpr.Traverser.Log.Warn().Msg("The config of c.Negotiate isn't a gin.Negotiate literal, so its responses can't be found")
+		return nil, nil
+	}
+
+	values := make(map[string]ast.Expr)
+	for _, elt := range compositeLit.Elts {
+		keyValue, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		if key, ok := keyValue.Key.(*ast.Ident); ok {
+			values[key.Name] = keyValue.Value
+		}
+	}
+
+	offered, ok := values["Offered"].(*ast.CompositeLit)
+	if !ok {
+		callExpr.Traverser.Log.Warn().Msg("The offered formats of c.Negotiate aren't a slice literal, so its responses can't be found")
+		return nil, nil
+	}
+
+	returnTypes := make([]astra.ReturnType, 0, len(offered.Elts)+1)
+	for _, elt := range offered.Elts {
+		mimeType, err := constantString(callExpr.File.Package.Package.TypesInfo, elt)
+		if err != nil {
+			continue
+		}
+
+		format, ok := negotiateFormats[mimeType]
+		if !ok {
+			continue
+		}
+
+		// HTML is rendered from a template, so it's always a string
+		returnType := astra.ReturnType{
+			StatusCode:  statusCode,
+			ContentType: format.contentType,
+			Field: astra.Field{
+				Type: "string",
+			},
+		}
+		if len(format.dataFields) > 0 {
+			returnType.Field.Type = "nil"
+		}
+
+		for _, dataField := range format.dataFields {
+			data, ok := values[dataField]
+			if !ok {
+				continue
+			}
+
+			dataType, err := callExpr.Traverser.Expression(data).Type()
+			if err != nil {
+				return nil, err
+			}
+
+			result, err := callExpr.Traverser.Type(dataType, callExpr.File.Package).Result()
+			if err != nil {
+				return nil, err
+			}
+
+			returnType.Field = astra.ParseResultToField(result)
+			break
+		}
+
+		returnTypes = append(returnTypes, returnType)
+	}
+
+	returnTypes = append(returnTypes, astra.ReturnType{
+		StatusCode: http.StatusNotAcceptable,
+		Field: astra.Field{
+			Type: "nil",
+		},
+	})
+
+	return returnTypes, nil
+}
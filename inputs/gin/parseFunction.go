@@ -4,10 +4,11 @@ import (
 	"errors"
 	"go/ast"
 	"go/types"
-	"strings"
+	"net/http"
 
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
 )
 
 const (
@@ -19,6 +20,13 @@ const (
 	GinContextIsPointer = true
 )
 
+// ParseRoutes parses routes from gin routes.
+// It will populate the routes with the handler function, which is parsed by parseFunction.
+// CreateRoutes must be called before this.
+func ParseRoutes() astra.ServiceFunction {
+	return handlerroute.ParseRoutes("gin", parseFunction)
+}
+
 // parseFunction parses a function and adds it to the service.
 // It is designed to be called recursively should it be required.
 // The level parameter is used to determine the depth of recursion.
@@ -29,7 +37,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 	traverser := funcTraverser.Traverser
 
 	traverser.SetActiveFile(activeFile)
-	traverser.SetAddComponentFunction(addComponent(s))
+	traverser.SetAddComponentFunction(addComponent(s, currRoute))
 
 	if level == 0 {
 		funcDoc, err := funcTraverser.Doc()
@@ -37,7 +45,9 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 			return err
 		}
 		if funcDoc != "" {
-			currRoute.Doc = strings.TrimSpace(funcDoc)
+			var docMetadata astTraversal.DocMetadata
+			currRoute.Doc, docMetadata = astTraversal.ParseDoc(funcDoc)
+			currRoute.Deprecated = docMetadata.Deprecated
 		}
 	}
 
@@ -105,7 +115,11 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 		} else {
 			var funcType *types.Func
 			funcType, err = callExpr.Type()
-			if err != nil {
+			// Built in functions and type conversions (i.e. http.Dir(".")) aren't functions that can be parsed
+			if errors.Is(err, astTraversal.ErrBuiltInFunction) || errors.Is(err, astTraversal.ErrInvalidNodeType) {
+				err = nil
+				return true
+			} else if err != nil {
 				return false
 			}
 
@@ -122,7 +136,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 
 			if signature.Recv() != nil && signature.Recv().Type().String() == signaturePath {
 				switch funcType.Name() {
-				case "JSON":
+				case "JSON", "IndentedJSON", "SecureJSON", "PureJSON", "AsciiJSON":
 					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						statusCode, ok := params[0].(int)
 						if !ok {
@@ -197,6 +211,68 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 					if err != nil {
 						return false
 					}
+				case "TOML":
+					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						result, ok := params[1].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/toml",
+							Field:       astra.ParseResultToField(result),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "JSONP": // c.JSONP is JSON, unless the callback query param is given, in which case the JSON is wrapped in a call to the callback
+					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						result, ok := params[1].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/json",
+							Field:       astra.ParseResultToField(result),
+						}, astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "application/javascript",
+							Field: astra.Field{
+								Type: "string",
+							},
+						})
+
+						// The callback query param is only added once, however many times the route calls JSONP
+						route.QueryParams = astra.AddParam(route.QueryParams, astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: "callback",
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
 				case "ProtoBuf":
 					currRoute, err = funcBuilder.StatusCode().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						statusCode, ok := params[0].(int)
@@ -286,6 +362,176 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 
 						return route, nil
 					})
+					if err != nil {
+						return false
+					}
+				case "Redirect": // c.Redirect
+					currRoute, err = funcBuilder.StatusCode().Ignored().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode: statusCode,
+							Field: astra.Field{
+								Type: "nil",
+							},
+							Headers: []astra.Param{
+								{
+									Name: "Location",
+									Field: astra.Field{
+										Type: "string",
+									},
+									IsRequired: true,
+								},
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "File", "FileFromFS": // c.File and c.FileFromFS
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						returnType := astra.ReturnType{
+							StatusCode:  http.StatusOK,
+							ContentType: "application/octet-stream",
+							Field: astra.Field{
+								Type: "file",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "FileAttachment": // c.FileAttachment
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						returnType := astra.ReturnType{
+							StatusCode:  http.StatusOK,
+							ContentType: "application/octet-stream",
+							Field: astra.Field{
+								Type: "file",
+							},
+							Headers: []astra.Param{
+								{
+									Name: "Content-Disposition",
+									Field: astra.Field{
+										Type: "string",
+									},
+									IsRequired: true,
+								},
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "DataFromReader": // c.DataFromReader
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						// The content type is only known if it's a constant
+						contentType, err := constantString(callExpr.File.Package.Package.TypesInfo, callExpr.Node.Args[2])
+						if err != nil {
+							contentType = "application/octet-stream"
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: contentType,
+							Field: astra.Field{
+								Type: "file",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "HTML": // c.HTML
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode:  statusCode,
+							ContentType: "text/html",
+							Field: astra.Field{
+								Type: "string",
+							},
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "Negotiate": // c.Negotiate
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnTypes, err := negotiateReturnTypes(callExpr, statusCode, callExpr.Node.Args[1])
+						if err != nil {
+							return nil, err
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnTypes...)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "Stream": // c.Stream, whose events are found from the calls to c.SSEvent in the step function
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						route.ReturnTypes = addStreamReturnType(route.ReturnTypes, astra.Field{
+							Type: "string",
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "SSEvent": // c.SSEvent
+					currRoute, err = funcBuilder.Ignored().ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[1].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						route.ReturnTypes = addStreamReturnType(route.ReturnTypes, astra.ParseResultToField(result))
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
 				// Query Param methods
 				case "GetQuery", "Query":
 					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
@@ -371,6 +617,23 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 					if err != nil {
 						return false
 					}
+				// Path Param methods
+				case "ShouldBindUri", "BindUri":
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						fields, err := uriBoundFields(callExpr, callExpr.Node.Args[0])
+						if err != nil {
+							return nil, err
+						}
+
+						for name, field := range fields {
+							route.PathParams = setPathParamField(route.PathParams, name, field)
+						}
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
 
 				// Body Param methods
 				case "ShouldBind", "Bind":
@@ -414,62 +677,36 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 					if err != nil {
 						return false
 					}
-				case "ShouldBindJSON", "BindJSON":
+				case "ShouldBindJSON", "BindJSON", "ShouldBindBodyWithJSON",
+					"ShouldBindXML", "BindXML", "ShouldBindBodyWithXML",
+					"ShouldBindYAML", "BindYAML", "ShouldBindBodyWithYAML",
+					"ShouldBindTOML", "BindTOML", "ShouldBindBodyWithTOML": // The binding engine is the format in the name of the method
+					engine := bindMethodEngine(funcType.Name())
 					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						result, ok := params[0].(astTraversal.Result)
 						if !ok {
 							return nil, errors.New("failed to parse result")
 						}
 
-						field := astra.ParseResultToField(result)
-
-						route.Body = append(route.Body, astra.BodyParam{
-							ContentType: "application/json",
-							IsBound:     true,
-							Field:       field,
-						})
-
-						return route, nil
+						return addBoundField(route, engine, astra.ParseResultToField(result)), nil
 					})
 					if err != nil {
 						return false
 					}
-				case "ShouldBindXML", "BindXML":
+				case "ShouldBindWith", "MustBindWith", "ShouldBindBodyWith": // The binding engine (i.e. binding.JSON) decides what is bound
 					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						result, ok := params[0].(astTraversal.Result)
 						if !ok {
 							return nil, errors.New("failed to parse result")
 						}
 
-						field := astra.ParseResultToField(result)
-
-						route.Body = append(route.Body, astra.BodyParam{
-							ContentType: "application/xml",
-							IsBound:     true,
-							Field:       field,
-						})
-
-						return route, nil
-					})
-					if err != nil {
-						return false
-					}
-				case "ShouldBindYAML", "BindYAML":
-					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
-						result, ok := params[0].(astTraversal.Result)
+						engine, ok := bindingEngine(callExpr.File.Package.Package.TypesInfo, callExpr.Node.Args[1])
 						if !ok {
-							return nil, errors.New("failed to parse result")
+							traverser.Log.Warn().Str("binding", types.ExprString(callExpr.Node.Args[1])).Msg("The binding isn't one of the binding engines of gin, so what it binds can't be found")
+							return route, nil
 						}
 
-						field := astra.ParseResultToField(result)
-
-						route.Body = append(route.Body, astra.BodyParam{
-							ContentType: "application/yaml",
-							IsBound:     true,
-							Field:       field,
-						})
-
-						return route, nil
+						return addBoundField(route, engine, astra.ParseResultToField(result)), nil
 					})
 					if err != nil {
 						return false
@@ -622,6 +859,41 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 
 						return route, nil
 					})
+				// Cookie methods
+				case "Cookie":
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, err := stringValue(traverser, callExpr.File.Package.Package.TypesInfo, callExpr.Node.Args[0])
+						if err != nil {
+							// The name of the cookie isn't known (i.e. it is a parameter of the handler), so the cookie is skipped rather than failing the route
+							traverser.Log.Warn().Err(err).Str("name", types.ExprString(callExpr.Node.Args[0])).Msg("The name of the cookie can't be found, so it won't be documented")
+							return route, nil
+						}
+
+						param := astra.Param{
+							Field: astra.Field{
+								Type: "string",
+							},
+							Name: name,
+						}
+
+						route.RequestCookies = append(route.RequestCookies, param)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "SetCookie":
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						cookie := setCookie(callExpr.File.Package.Package.TypesInfo, callExpr.Node.Args)
+
+						route.ResponseCookies = append(route.ResponseCookies, cookie)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
 				case "AbortWithError":
 					currRoute, err = funcBuilder.StatusCode().Ignored().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						statusCode, ok := params[0].(int)
@@ -690,6 +962,15 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						return false
 					}
 				}
+			} else if field, ok := pathParamConversions[funcType.FullName()]; ok {
+				// A path param that is converted from its string (i.e. strconv.Atoi(c.Param("id"))) has the type that it's converted to
+				if name, found := pathParamName(callExpr, funcTraverser.Node.Body, ctxName, callExpr.Node.Args[0]); found {
+					currRoute.PathParams = setPathParamField(currRoute.PathParams, name, field)
+				}
+			} else if isFlushFunc(funcType, signature) {
+				currRoute.ReturnTypes = addStreamReturnType(currRoute.ReturnTypes, astra.Field{
+					Type: "string",
+				})
 			}
 		}
 
@@ -707,12 +988,12 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 	return nil
 }
 
-func addComponent(s *astra.Service) func(astTraversal.Result) error {
+func addComponent(s *astra.Service, currRoute *astra.Route) func(astTraversal.Result) error {
 	return func(result astTraversal.Result) error {
 		field := astra.ParseResultToField(result)
 
 		if field.Package != "" {
-			s.Components = astra.AddComponent(s.Components, field)
+			s.AddComponent(currRoute, field)
 		}
 		return nil
 	}
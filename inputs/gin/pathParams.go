@@ -0,0 +1,155 @@
+package gin
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// uuidPathParamField is the field of a path param that is parsed as a UUID.
+// It is a string with the uuid validation rule, so that it has the same format as a field validated as a UUID.
+var uuidPathParamField = astra.Field{
+	Type: "string",
+	StructFieldValidationTags: astTraversal.ValidationTagMap{
+		astTraversal.GinValidationTag: {
+			Rules: []astTraversal.ValidationRule{{Name: "uuid"}},
+		},
+	},
+}
+
+// pathParamConversions are the functions that convert the string of a path param to another type, by their full name, with the field of the type that they convert to.
+var pathParamConversions = map[string]astra.Field{
+	"strconv.Atoi":                             {Type: "int"},
+	"strconv.ParseInt":                         {Type: "int64"},
+	"strconv.ParseUint":                        {Type: "uint64"},
+	"strconv.ParseFloat":                       {Type: "float64"},
+	"strconv.ParseBool":                        {Type: "bool"},
+	"github.com/google/uuid.Parse":             uuidPathParamField,
+	"github.com/google/uuid.MustParse":         uuidPathParamField,
+	"github.com/gofrs/uuid.FromString":         uuidPathParamField,
+	"github.com/gofrs/uuid.FromStringOrNil":    uuidPathParamField,
+	"github.com/gofrs/uuid/v5.FromString":      uuidPathParamField,
+	"github.com/gofrs/uuid/v5.FromStringOrNil": uuidPathParamField,
+}
+
+// setPathParamField sets the field of the path param with the name, if the route has it.
+// Path params are found from the path of the route, so a name that isn't in the path isn't a path param.
+func setPathParamField(pathParams []astra.Param, name string, field astra.Field) []astra.Param {
+	for i, pathParam := range pathParams {
+		if pathParam.IsBound || pathParam.Name != name {
+			continue
+		}
+
+		pathParams[i].Field = field
+	}
+
+	return pathParams
+}
+
+// pathParamName finds the name of the path param that an expression is the value of.
+// The expression is either a call to c.Param, or a variable that is assigned one in the body of the function.
+func pathParamName(callExpr *astTraversal.CallExpressionTraverser, body *ast.BlockStmt, ctxName string, expr ast.Expr) (string, bool) {
+	info := callExpr.File.Package.Package.TypesInfo
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj := info.ObjectOf(ident)
+		if obj == nil {
+			return "", false
+		}
+
+		expr = nil
+		ast.Inspect(body, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.AssignStmt:
+				if len(node.Lhs) != len(node.Rhs) {
+					return true
+				}
+
+				for i, lhs := range node.Lhs {
+					if lhsIdent, ok := lhs.(*ast.Ident); ok && info.ObjectOf(lhsIdent) == obj {
+						expr = node.Rhs[i]
+					}
+				}
+			case *ast.ValueSpec:
+				if len(node.Names) != len(node.Values) {
+					return true
+				}
+
+				for i, name := range node.Names {
+					if info.ObjectOf(name) == obj {
+						expr = node.Values[i]
+					}
+				}
+			}
+
+			return expr == nil
+		})
+	}
+
+	paramCall, ok := expr.(*ast.CallExpr)
+	if !ok || len(paramCall.Args) != 1 {
+		return "", false
+	}
+
+	selector, ok := paramCall.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "Param" {
+		return "", false
+	}
+
+	if ctxIdent, ok := selector.X.(*ast.Ident); !ok || ctxIdent.Name != ctxName {
+		return "", false
+	}
+
+	name, err := constantString(info, paramCall.Args[0])
+	if err != nil {
+		return "", false
+	}
+
+	return name, true
+}
+
+// uriBoundFields finds the fields of a struct that is bound with c.ShouldBindUri, by the name in their uri tag.
+func uriBoundFields(callExpr *astTraversal.CallExpressionTraverser, expr ast.Expr) (map[string]astra.Field, error) {
+	exprType, err := callExpr.Traverser.Expression(expr).Type()
+	if err != nil {
+		return nil, err
+	}
+
+	if pointer, ok := exprType.(*types.Pointer); ok {
+		exprType = pointer.Elem()
+	}
+
+	// The fields of a named struct are found in the package that it is declared in
+	pkg := callExpr.File.Package
+	if named, ok := exprType.(*types.Named); ok && named.Obj().Pkg() != nil {
+		pkg = callExpr.Traverser.Packages.FindOrAdd(named.Obj().Pkg().Path())
+		_, err = callExpr.Traverser.Packages.Get(pkg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	structType, ok := exprType.Underlying().(*types.Struct)
+	if !ok {
+		return nil, nil
+	}
+
+	result, err := callExpr.Traverser.Type(structType, pkg).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]astra.Field)
+	for _, structField := range astra.ParseResultToField(result).StructFields {
+		bindingTag, ok := structField.StructFieldBindingTags[astTraversal.URIBindingTag]
+		if !ok || bindingTag.NotShown {
+			continue
+		}
+
+		fields[bindingTag.Name] = structField
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,68 @@
+package gin
+
+import (
+	"go/types"
+	"net/http"
+	"reflect"
+
+	"github.com/ls6-events/astra"
+)
+
+// streamContentType is the content type of a response that is streamed as Server-Sent Events.
+const streamContentType = "text/event-stream"
+
+// flushReceivers are the receivers of the Flush methods that stream a response written to c.Writer.
+// gin.ResponseWriter embeds http.Flusher, so c.Writer.Flush() has the receiver of http.Flusher.
+var flushReceivers = []string{
+	"net/http.Flusher",
+	"*net/http.ResponseController",
+}
+
+// isFlushFunc checks whether a function flushes the response writer, which means that the response is streamed.
+func isFlushFunc(funcType *types.Func, signature *types.Signature) bool {
+	if funcType.Name() != "Flush" || signature.Recv() == nil {
+		return false
+	}
+
+	for _, receiver := range flushReceivers {
+		if signature.Recv().Type().String() == receiver {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addStreamReturnType adds the return type of a streamed response.
+// A stream without a known type of event (i.e. c.Stream or a flushed write) is a string, which is replaced by the type of its events once one is found.
+// A stream can have events of different types, so each type of event is added as its own return type.
+func addStreamReturnType(returnTypes []astra.ReturnType, field astra.Field) []astra.ReturnType {
+	returnType := astra.ReturnType{
+		StatusCode:  http.StatusOK,
+		ContentType: streamContentType,
+		Field:       field,
+		IsStream:    true,
+	}
+
+	for i, existingReturn := range returnTypes {
+		if !existingReturn.IsStream || existingReturn.StatusCode != returnType.StatusCode {
+			continue
+		}
+
+		if isUntyped(existingReturn.Field) {
+			returnTypes[i] = returnType
+			return returnTypes
+		}
+
+		if isUntyped(field) || reflect.DeepEqual(existingReturn.Field, field) {
+			return returnTypes
+		}
+	}
+
+	return append(returnTypes, returnType)
+}
+
+// isUntyped checks whether the field of a stream is a string, which is the type of a stream without a known type of event.
+func isUntyped(field astra.Field) bool {
+	return field.Type == "string" && field.Package == ""
+}
@@ -1,13 +1,27 @@
 package inputs
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	"github.com/go-chi/chi/v5"
+	"github.com/gofiber/fiber/v2"
+	"github.com/labstack/echo/v4"
 	"github.com/ls6-events/astra"
+	astraChi "github.com/ls6-events/astra/inputs/chi"
+	astraEcho "github.com/ls6-events/astra/inputs/echo"
+	astraFiber "github.com/ls6-events/astra/inputs/fiber"
 	astraGin "github.com/ls6-events/astra/inputs/gin"
+	astraNetHTTP "github.com/ls6-events/astra/inputs/nethttp"
 )
 
 const (
-	InputModeGin astra.InputMode = "gin" // github.com/gin-gonic/gin web framework.
+	InputModeGin       astra.InputMode = "gin"        // github.com/gin-gonic/gin web framework.
+	InputModeGinStatic astra.InputMode = "gin-static" // github.com/gin-gonic/gin web framework, with the routes discovered from the source code.
+	InputModeEcho      astra.InputMode = "echo"       // github.com/labstack/echo web framework.
+	InputModeChi       astra.InputMode = "chi"        // github.com/go-chi/chi router.
+	InputModeNetHTTP   astra.InputMode = "nethttp"    // net/http ServeMux (Go 1.22 patterns).
+	InputModeFiber     astra.InputMode = "fiber"      // github.com/gofiber/fiber web framework.
 )
 
 func addInput(mode astra.InputMode, createRoutes astra.ServiceFunction, parseRoutes astra.ServiceFunction) astra.Option {
@@ -31,3 +45,65 @@ func WithGinInput(router *gin.Engine) astra.Option {
 		astraGin.ParseRoutes(),
 	)
 }
+
+// WithGinStaticInput adds gin as an input to the service, without needing the gin engine.
+// CreateRoutes is called before ParseRoutes.
+// CreateRoutes will statically discover the routes from the source code of the working directory - it will find the route methods and group prefixes, and refer to the handler function by name, file and line number.
+// This means the routes can be created without running the program (i.e. in CI), and the CLI will rediscover them on every run instead of using the cached routes.
+// ParseRoutes will populate the routes with the handler function, in the same way as WithGinInput.
+func WithGinStaticInput() astra.Option {
+	return addInput(
+		InputModeGinStatic,
+		astraGin.DiscoverRoutes(),
+		astraGin.ParseRoutes(),
+	)
+}
+
+// WithEchoInput adds echo as an input to the service.
+// CreateRoutes is called before ParseRoutes.
+// CreateRoutes is the only function that will have access to the echo instance - it will create the routes and refer to the handler function by name, file and line number.
+// ParseRoutes will populate the routes with the handler function, should not need access to the echo instance because there will be cases where it is nil (CLI).
+func WithEchoInput(e *echo.Echo) astra.Option {
+	return addInput(
+		InputModeEcho,
+		astraEcho.CreateRoutes(e),
+		astraEcho.ParseRoutes(),
+	)
+}
+
+// WithChiInput adds chi as an input to the service.
+// CreateRoutes is called before ParseRoutes.
+// CreateRoutes is the only function that will have access to the router - it will create the routes and refer to the handler function by name, file and line number.
+// ParseRoutes will populate the routes with the handler function, should not need access to the router because there will be cases where it is nil (CLI).
+func WithChiInput(router chi.Routes) astra.Option {
+	return addInput(
+		InputModeChi,
+		astraChi.CreateRoutes(router),
+		astraChi.ParseRoutes(),
+	)
+}
+
+// WithNetHTTPInput adds the net/http ServeMux as an input to the service.
+// CreateRoutes is called before ParseRoutes.
+// The ServeMux doesn't expose its registered patterns, so the patterns to document can be passed in, and they will be looked up on the ServeMux to find their handlers.
+// If no patterns are passed in, the routes are discovered statically by finding the calls to HandleFunc and Handle in the source code of the working directory (the ServeMux can be nil in this case).
+// ParseRoutes will populate the routes with the handler function, should not need access to the ServeMux because there will be cases where it is nil (CLI).
+func WithNetHTTPInput(mux *http.ServeMux, patterns ...string) astra.Option {
+	return addInput(
+		InputModeNetHTTP,
+		astraNetHTTP.CreateRoutes(mux, patterns),
+		astraNetHTTP.ParseRoutes(),
+	)
+}
+
+// WithFiberInput adds fiber as an input to the service.
+// CreateRoutes is called before ParseRoutes.
+// CreateRoutes is the only function that will have access to the fiber app - it will create the routes and refer to the handler function by name, file and line number.
+// ParseRoutes will populate the routes with the handler function, should not need access to the fiber app because there will be cases where it is nil (CLI).
+func WithFiberInput(app *fiber.App) astra.Option {
+	return addInput(
+		InputModeFiber,
+		astraFiber.CreateRoutes(app),
+		astraFiber.ParseRoutes(),
+	)
+}
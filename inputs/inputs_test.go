@@ -15,3 +15,58 @@ func TestWithGinInput(t *testing.T) {
 
 	require.Len(t, service.Inputs, 1)
 }
+
+func TestWithEchoInput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Inputs, 0)
+
+	WithEchoInput(nil)(service)
+
+	require.Len(t, service.Inputs, 1)
+	require.Equal(t, InputModeEcho, service.Inputs[0].Mode)
+}
+
+func TestWithChiInput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Inputs, 0)
+
+	WithChiInput(nil)(service)
+
+	require.Len(t, service.Inputs, 1)
+	require.Equal(t, InputModeChi, service.Inputs[0].Mode)
+}
+
+func TestWithNetHTTPInput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Inputs, 0)
+
+	WithNetHTTPInput(nil)(service)
+
+	require.Len(t, service.Inputs, 1)
+	require.Equal(t, InputModeNetHTTP, service.Inputs[0].Mode)
+}
+
+func TestWithFiberInput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Inputs, 0)
+
+	WithFiberInput(nil)(service)
+
+	require.Len(t, service.Inputs, 1)
+	require.Equal(t, InputModeFiber, service.Inputs[0].Mode)
+}
+
+func TestWithGinStaticInput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Inputs, 0)
+
+	WithGinStaticInput()(service)
+
+	require.Len(t, service.Inputs, 1)
+	require.Equal(t, InputModeGinStatic, service.Inputs[0].Mode)
+}
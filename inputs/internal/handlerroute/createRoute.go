@@ -1,19 +1,19 @@
-package gin
+// Package handlerroute creates and parses the routes whose handlers are located by their name, file and line number.
+// It is shared by the inputs, which each only find the routes from their router and parse the handlers of their framework.
+package handlerroute
 
 import (
 	"os"
 	"path/filepath"
 
 	"github.com/ls6-events/astra"
-
-	"github.com/gin-gonic/gin"
 )
 
-// createRoute creates a route from a gin RouteInfo.
+// CreateRoute creates a route from a method, path and handler name found by an input.
 // It will only create the route and refer to the handler function by name, file and line number.
-// The route will be populated later by parseRoute.
-func createRoute(s *astra.Service, file string, line int, info gin.RouteInfo) error {
-	log := s.Log.With().Str("path", info.Path).Str("method", info.Method).Str("handler", info.Handler).Logger()
+// The route will be populated later by ParseRoutes.
+func CreateRoute(s *astra.Service, file string, line int, method string, path string, handlerName string) error {
+	log := s.Log.With().Str("path", path).Str("method", method).Str("handler", handlerName).Logger()
 
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -28,11 +28,11 @@ func createRoute(s *astra.Service, file string, line int, info gin.RouteInfo) er
 	}
 
 	baseRoute := astra.Route{
-		Handler:     info.Handler,
+		Handler:     handlerName,
 		File:        relativePath,
 		LineNo:      line,
-		Path:        info.Path,
-		Method:      info.Method,
+		Path:        path,
+		Method:      method,
 		PathParams:  make([]astra.Param, 0),
 		Body:        make([]astra.BodyParam, 0),
 		QueryParams: make([]astra.Param, 0),
@@ -1,4 +1,4 @@
-package gin
+package handlerroute
 
 import (
 	"fmt"
@@ -12,23 +12,18 @@ import (
 	"github.com/iancoleman/strcase"
 )
 
-// parseRoute parses a route from a gin routes.
+// ParseFunction parses the handler function of a route, for the framework of the input.
+type ParseFunction func(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error
+
+// parseRoute parses a route created by CreateRoute.
 // It will populate the route with the handler function.
-// createRoute must be called before this.
 // It will open the file as an AST and find the handler function using the line number and function name.
 // It can also find the path parameters from the handler function.
 // It calls the parseFunction function to parse the handler function.
-func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
+func parseRoute(s *astra.Service, baseRoute *astra.Route, parseFunction ParseFunction) error {
 	log := s.Log.With().Str("path", baseRoute.Path).Str("method", baseRoute.Method).Str("file", baseRoute.File).Logger()
 
-	traverser := astTraversal.New(s.WorkDir).SetLog(&log)
-
-	traverser.Packages.AddPathLoader(func(path string) (string, error) {
-		if path == "main" {
-			return s.GetMainPackageName()
-		}
-		return path, nil
-	})
+	traverser := astTraversal.New(s.WorkDir).SetLog(&log).SetPackageManager(s.RoutePackageManager(baseRoute)).SetInterfaces(s.Interfaces)
 
 	handler := utils.SplitHandlerPath(baseRoute.Handler)
 
@@ -76,6 +71,8 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 		log.Debug().Msg("No path params found")
 	}
 
+	// The failure to parse the handler is kept, as it can't be returned from within the traversal
+	var parseErr error
 	ast.Inspect(traverser.ActiveFile().AST, func(n ast.Node) bool {
 		if n == nil {
 			return true
@@ -83,7 +80,7 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 
 		funcDecl, ok := n.(*ast.FuncDecl)
 
-		if ok && funcDecl.Name.Name == funcName {
+		if ok && handler.IsFuncDecl(funcDecl) {
 			log.Debug().Str("funcName", funcName).Msg("Found handler function")
 
 			startPos := traverser.ActiveFile().Package.Package.Fset.Position(funcDecl.Pos())
@@ -108,12 +105,14 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 							function, err := traverser.Function(funcLit)
 							if err != nil {
 								log.Error().Err(err).Msg("Failed to get function")
+								parseErr = err
 								return false
 							}
 
 							err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0)
 							if err != nil {
 								log.Error().Err(err).Msg("Failed to parse inline function")
+								parseErr = err
 								return false
 							}
 
@@ -133,6 +132,7 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 			function, err := traverser.Function(funcDecl)
 			if err != nil {
 				log.Error().Err(err).Msg("Failed to get function")
+				parseErr = err
 				return false
 			}
 
@@ -142,6 +142,7 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 			err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0)
 			if err != nil {
 				log.Error().Err(err).Msg("Failed to parse function")
+				parseErr = err
 				return false
 			}
 
@@ -153,9 +154,5 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 		return true
 	})
 
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return parseErr
 }
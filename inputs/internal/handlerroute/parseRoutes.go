@@ -0,0 +1,42 @@
+package handlerroute
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/utils"
+)
+
+// ParseRoutes parses the routes created by an input (i.e. gin), with the parse function for its framework.
+// It will populate the routes with the handler function.
+// It will individually call parseRoute for each route, parsing up to the configured concurrency of routes at the same time.
+// The input's createRoutes must be called before this.
+func ParseRoutes(inputName string, parseFunction ParseFunction) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Str("input", inputName).Msg("Populating routes from input routes")
+
+		// The packages of all the handlers are loaded at once, so the dependencies they share are only type checked once
+		err := s.LoadPackages(utils.HandlerPackagePaths(s.RoutesToParse())...)
+		if err != nil {
+			// Each package will be loaded separately when it is needed instead, which will report any errors for the route that needs it
+			s.Log.Warn().Err(err).Msg("Failed to load the handler packages together")
+		}
+
+		err = s.ParseEachRoute(func(route *astra.Route) error {
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
+
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", route.File).Int("line", route.LineNo).Msg("Parsing route")
+			err := parseRoute(s, route, parseFunction)
+			if err != nil {
+				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", route.File).Int("line", route.LineNo).Err(err).Msg("Failed to parse route")
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		s.Log.Debug().Str("input", inputName).Msg("Populated service with input routes")
+
+		return nil
+	}
+}
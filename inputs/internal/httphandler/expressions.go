@@ -0,0 +1,120 @@
+package httphandler
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// isVariableChain checks whether the expression is a method call on a chain of selectors and calls from a variable.
+// For example, r.URL.Query().Get matches the variable r with the chain "URL", "Query".
+func isVariableChain(expr ast.Expr, varName string, chain ...string) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	return isVariable(selector.X, varName, chain...)
+}
+
+// isVariable checks whether the expression is a chain of selectors and calls from a variable.
+// For example, r.Body matches the variable r with the chain "Body".
+func isVariable(expr ast.Expr, varName string, chain ...string) bool {
+	if varName == "" {
+		return false
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		if callExpr, ok := expr.(*ast.CallExpr); ok {
+			expr = callExpr.Fun
+		}
+
+		selector, ok := expr.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != chain[i] {
+			return false
+		}
+		expr = selector.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == varName
+}
+
+// isJSONCoderOf checks whether a method call is on a JSON encoder or decoder that is created from a variable.
+// For example, json.NewDecoder(r.Body).Decode matches the constructor "NewDecoder" with the variable r and the chain "Body".
+// The encoder or decoder is either created in the call, or assigned to a variable in the body of the function.
+func isJSONCoderOf(callExpr *astTraversal.CallExpressionTraverser, body *ast.BlockStmt, constructor string, varName string, chain ...string) bool {
+	selector, ok := callExpr.Node.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	info := callExpr.File.Package.Package.TypesInfo
+
+	coder := selector.X
+	if ident, ok := coder.(*ast.Ident); ok {
+		coder = assignedValue(info, body, ident)
+	}
+
+	coderCall, ok := coder.(*ast.CallExpr)
+	if !ok || len(coderCall.Args) != 1 {
+		return false
+	}
+
+	var coderFunc *ast.Ident
+	switch fun := coderCall.Fun.(type) {
+	case *ast.Ident:
+		coderFunc = fun
+	case *ast.SelectorExpr:
+		coderFunc = fun.Sel
+	default:
+		return false
+	}
+
+	funcObj, ok := info.Uses[coderFunc].(*types.Func)
+	if !ok || funcObj.FullName() != JSONPackagePath+"."+constructor {
+		return false
+	}
+
+	return isVariable(coderCall.Args[0], varName, chain...)
+}
+
+// assignedValue finds the value that is assigned to a variable in the body of the function.
+// It returns nil if the variable isn't assigned a single value there.
+func assignedValue(info *types.Info, body *ast.BlockStmt, ident *ast.Ident) ast.Expr {
+	obj := info.ObjectOf(ident)
+	if obj == nil {
+		return nil
+	}
+
+	var value ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) {
+				return true
+			}
+
+			for i, lhs := range node.Lhs {
+				if lhsIdent, ok := lhs.(*ast.Ident); ok && info.ObjectOf(lhsIdent) == obj {
+					value = node.Rhs[i]
+				}
+			}
+		case *ast.ValueSpec:
+			if len(node.Names) != len(node.Values) {
+				return true
+			}
+
+			for i, name := range node.Names {
+				if info.ObjectOf(name) == obj {
+					value = node.Values[i]
+				}
+			}
+		}
+
+		return value == nil
+	})
+
+	return value
+}
@@ -0,0 +1,496 @@
+// Package httphandler parses the handlers that use a http.ResponseWriter and *http.Request.
+// It is shared by the inputs whose routers register the handlers of the net/http package.
+package httphandler
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"net/http"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+const (
+	// HTTPPackagePath is the import path of the net/http package.
+	HTTPPackagePath = "net/http"
+	// JSONPackagePath is the import path of the encoding/json package.
+	JSONPackagePath = "encoding/json"
+	// ResponseWriterType is the type of the response writer variable.
+	ResponseWriterType = "ResponseWriter"
+	// ResponseWriterIsPointer is whether the response writer variable is a pointer for the handler functions.
+	ResponseWriterIsPointer = false
+	// RequestType is the type of the request variable.
+	RequestType = "Request"
+	// RequestIsPointer is whether the request variable is a pointer for the handler functions.
+	RequestIsPointer = true
+)
+
+// Router is the parts of parsing a handler that depend on the router that it is registered with.
+type Router struct {
+	// PackagePath is the import path of the router package, whose functions aren't traversed as they aren't handlers.
+	PackagePath string
+	// PathParamArg finds the index of the argument that is the name of the path param read by a function, if it reads one.
+	PathParamArg func(funcType *types.Func, signature *types.Signature) (int, bool)
+}
+
+// ParseFunction parses a function and adds it to the service.
+// It is designed to be called recursively should it be required.
+// The level parameter is used to determine the depth of recursion.
+// And the package name and path are used to determine the package of the currently analysed function.
+// The currRoute reference is used to manipulate the current route being analysed.
+// The router is used to find the path params, which are read differently by each router.
+func ParseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int, router Router) error {
+	traverser := funcTraverser.Traverser
+
+	traverser.SetActiveFile(activeFile)
+	traverser.SetAddComponentFunction(addComponent(s, currRoute))
+
+	if level == 0 {
+		funcDoc, err := funcTraverser.Doc()
+		if err != nil {
+			return err
+		}
+		if funcDoc != "" {
+			var docMetadata astTraversal.DocMetadata
+			currRoute.Doc, docMetadata = astTraversal.ParseDoc(funcDoc)
+			currRoute.Deprecated = docMetadata.Deprecated
+		}
+	}
+
+	writerName := funcTraverser.FindArgumentNameByType(ResponseWriterType, HTTPPackagePath, ResponseWriterIsPointer)
+	requestName := funcTraverser.FindArgumentNameByType(RequestType, HTTPPackagePath, RequestIsPointer)
+	if writerName == "" && requestName == "" {
+		return errors.New("failed to find response writer or request variable name")
+	}
+
+	// statusCode is the status code set by the last call to WriteHeader.
+	// The response body written after it in the same block will be documented with that status code (or 200 if it was never set).
+	var statusCode int
+	var statusBlockEnd token.Pos
+
+	var err error
+	// Loop over every statement in the function
+	ast.Inspect(funcTraverser.Node.Body, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+		// If a function is called
+		var callExpr *astTraversal.CallExpressionTraverser
+		callExpr, err = traverser.CallExpression(n)
+		if errors.Is(err, astTraversal.ErrInvalidNodeType) {
+			err = nil
+			return true
+		} else if err != nil {
+			return true
+		}
+
+		funcBuilder := astra.NewContextFuncBuilder(currRoute, callExpr)
+
+		// Loop over every custom function
+		// If the custom function returns a route, use that route instead of the current route
+		// And break out of this AST traversal for this call expression
+		// Otherwise, continue on
+		// The response writer is passed as the context variable, as it is the variable used to respond
+		var shouldBreak bool
+		for _, customFunc := range s.CustomFuncs {
+			var newRoute *astra.Route
+			newRoute, err = customFunc(writerName, funcBuilder)
+			if err != nil {
+				return false
+			}
+			if newRoute != nil {
+				currRoute = newRoute
+				shouldBreak = true
+				break
+			}
+		}
+		if shouldBreak {
+			return true
+		}
+
+		var funcType *types.Func
+		funcType, err = callExpr.Type()
+		// Built in functions and type conversions (i.e. []byte("ok")) aren't functions that can be parsed
+		if errors.Is(err, astTraversal.ErrBuiltInFunction) || errors.Is(err, astTraversal.ErrInvalidNodeType) {
+			err = nil
+			return true
+		} else if err != nil {
+			return false
+		}
+
+		signature, ok := funcType.Type().(*types.Signature)
+		if !ok {
+			traverser.Log.Error().Err(err).Msg("error getting function signature")
+			return false
+		}
+
+		var recvType string
+		if signature.Recv() != nil {
+			recvType = signature.Recv().Type().String()
+		}
+
+		var pkgPath string
+		if funcType.Pkg() != nil {
+			pkgPath = funcType.Pkg().Path()
+		}
+
+		var nameIndex int
+		var readsPathParam bool
+		if router.PathParamArg != nil {
+			nameIndex, readsPathParam = router.PathParamArg(funcType, signature)
+		}
+
+		switch {
+		// Response body methods
+		// Only an encoder that writes to the response writer writes the response body
+		case recvType == "*"+JSONPackagePath+".Encoder" && funcType.Name() == "Encode" && isJSONCoderOf(callExpr, funcTraverser.Node.Body, "NewEncoder", writerName):
+			currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				result, ok := params[0].(astTraversal.Result)
+				if !ok {
+					return nil, errors.New("failed to parse result")
+				}
+
+				returnType := astra.ReturnType{
+					StatusCode:  responseStatusCode(statusCode, statusBlockEnd, callExpr.Node.Pos()),
+					ContentType: "application/json",
+					Field:       astra.ParseResultToField(result),
+				}
+
+				route.ReturnTypes = astra.AddReturnType(removeEmptyReturnType(route.ReturnTypes, returnType.StatusCode), returnType)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+
+			// The status code only applies to the body that is written directly after it
+			statusCode = 0
+		case recvType == HTTPPackagePath+"."+ResponseWriterType && funcType.Name() == "Write":
+			returnType := astra.ReturnType{
+				StatusCode:  responseStatusCode(statusCode, statusBlockEnd, callExpr.Node.Pos()),
+				ContentType: "text/plain",
+				Field: astra.Field{
+					Type: "string",
+				},
+			}
+
+			currRoute.ReturnTypes = astra.AddReturnType(removeEmptyReturnType(currRoute.ReturnTypes, returnType.StatusCode), returnType)
+
+			statusCode = 0
+		case recvType == HTTPPackagePath+"."+ResponseWriterType && funcType.Name() == "WriteHeader":
+			currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				var ok bool
+				statusCode, ok = params[0].(int)
+				if !ok {
+					return nil, errors.New("failed to parse status code")
+				}
+				statusBlockEnd = enclosingBlockEnd(funcTraverser.Node.Body, callExpr.Node.Pos())
+
+				returnType := astra.ReturnType{
+					StatusCode: statusCode,
+					Field: astra.Field{
+						Type: "nil",
+					},
+				}
+
+				route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+		case pkgPath == HTTPPackagePath && signature.Recv() == nil && funcType.Name() == "Error":
+			currRoute, err = funcBuilder.Ignored().Ignored().StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				statusCode, ok := params[2].(int)
+				if !ok {
+					return nil, errors.New("failed to parse status code")
+				}
+
+				returnType := astra.ReturnType{
+					StatusCode:  statusCode,
+					ContentType: "text/plain",
+					Field: astra.Field{
+						Type: "string",
+					},
+				}
+
+				route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+		case pkgPath == HTTPPackagePath && signature.Recv() == nil && (funcType.Name() == "ServeFile" || funcType.Name() == "ServeContent"):
+			currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				returnType := astra.ReturnType{
+					StatusCode:  http.StatusOK,
+					ContentType: "application/octet-stream",
+					Field: astra.Field{
+						Type: "file",
+					},
+				}
+
+				route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+		case pkgPath == HTTPPackagePath && signature.Recv() == nil && funcType.Name() == "Redirect":
+			currRoute, err = funcBuilder.Ignored().Ignored().Ignored().StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				statusCode, ok := params[3].(int)
+				if !ok {
+					return nil, errors.New("failed to parse status code")
+				}
+
+				returnType := astra.ReturnType{
+					StatusCode: statusCode,
+					Field: astra.Field{
+						Type: "nil",
+					},
+				}
+
+				route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+
+		// Body Param methods
+		// Only a decoder that reads from the request body reads the request body
+		case recvType == "*"+JSONPackagePath+".Decoder" && funcType.Name() == "Decode" && isJSONCoderOf(callExpr, funcTraverser.Node.Body, "NewDecoder", requestName, "Body"):
+			currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				result, ok := params[0].(astTraversal.Result)
+				if !ok {
+					return nil, errors.New("failed to parse result")
+				}
+
+				field := astra.ParseResultToField(result)
+
+				route.Body = append(route.Body, astra.BodyParam{
+					ContentType: "application/json",
+					IsBound:     true,
+					Field:       field,
+				})
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+
+		// Path Param methods
+		case readsPathParam:
+			for i := 0; i < nameIndex; i++ {
+				funcBuilder.Ignored()
+			}
+
+			currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				name, ok := params[nameIndex].(string)
+				if !ok {
+					return nil, errors.New("failed to parse name")
+				}
+
+				return addPathParam(route, name), nil
+			})
+			if err != nil {
+				return false
+			}
+
+		// Query Param methods
+		case recvType == "net/url.Values" && funcType.Name() == "Get" && isVariableChain(callExpr.Node.Fun, requestName, "URL", "Query"):
+			currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				name, ok := params[0].(string)
+				if !ok {
+					return nil, errors.New("failed to parse name")
+				}
+
+				param := astra.Param{
+					Field: astra.Field{
+						Type: "string",
+					},
+					Name: name,
+				}
+
+				route.QueryParams = append(route.QueryParams, param)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+
+		// Header methods
+		case recvType == HTTPPackagePath+".Header" && funcType.Name() == "Get" && isVariableChain(callExpr.Node.Fun, requestName, "Header"):
+			currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				name, ok := params[0].(string)
+				if !ok {
+					return nil, errors.New("failed to parse name")
+				}
+
+				param := astra.Param{
+					Field: astra.Field{
+						Type: "string",
+					},
+					Name: name,
+				}
+
+				route.RequestHeaders = append(route.RequestHeaders, param)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+		case recvType == HTTPPackagePath+".Header" && (funcType.Name() == "Set" || funcType.Name() == "Add") && isVariableChain(callExpr.Node.Fun, writerName, "Header"):
+			currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+				name, ok := params[0].(string)
+				if !ok {
+					return nil, errors.New("failed to parse name")
+				}
+
+				param := astra.Param{
+					Field: astra.Field{
+						Type: "string",
+					},
+					Name: name,
+				}
+
+				route.ResponseHeaders = append(route.ResponseHeaders, param)
+
+				return route, nil
+			})
+			if err != nil {
+				return false
+			}
+
+		// If the function takes the response writer or request as an argument, traverse it
+		// Functions from the net/http and router packages are skipped, as they aren't handlers
+		case pkgPath != HTTPPackagePath && pkgPath != router.PackagePath && takesHandlerArguments(signature):
+			_, hasWriter := callExpr.ArgIndex(writerName)
+			_, hasRequest := callExpr.ArgIndex(requestName)
+			if !hasWriter && !hasRequest {
+				return true
+			}
+
+			var function *astTraversal.FunctionTraverser
+			function, err = callExpr.Function()
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("failed to get function")
+				return false
+			}
+
+			err = ParseFunction(s, function, currRoute, function.Traverser.ActiveFile(), level+1, router)
+			if err != nil {
+				traverser.Log.Error().Err(err).Msg("error parsing function")
+				return false
+			}
+
+			traverser.SetActiveFile(activeFile)
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(currRoute.ReturnTypes) == 0 && level == 0 {
+		return errors.New("return type not found")
+	}
+
+	return nil
+}
+
+// responseStatusCode returns the status code of a response written at the position.
+// It defaults to 200 if WriteHeader wasn't called, or if it was called in a block that has since ended.
+func responseStatusCode(statusCode int, statusBlockEnd token.Pos, pos token.Pos) int {
+	if statusCode == 0 || pos > statusBlockEnd {
+		return http.StatusOK
+	}
+
+	return statusCode
+}
+
+// enclosingBlockEnd finds the end of the innermost block that contains the position.
+func enclosingBlockEnd(body *ast.BlockStmt, pos token.Pos) token.Pos {
+	end := body.End()
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false
+		}
+
+		if block, ok := n.(*ast.BlockStmt); ok {
+			end = block.End()
+		}
+
+		return true
+	})
+
+	return end
+}
+
+// removeEmptyReturnType removes the empty return type recorded by WriteHeader for a status code, as a body has been written for it.
+func removeEmptyReturnType(returnTypes []astra.ReturnType, statusCode int) []astra.ReturnType {
+	filtered := make([]astra.ReturnType, 0, len(returnTypes))
+	for _, returnType := range returnTypes {
+		if returnType.StatusCode == statusCode && returnType.Field.Type == "nil" {
+			continue
+		}
+		filtered = append(filtered, returnType)
+	}
+
+	return filtered
+}
+
+// addPathParam adds a path parameter to the route if it isn't already defined by the path.
+func addPathParam(route *astra.Route, name string) *astra.Route {
+	for _, pathParam := range route.PathParams {
+		if pathParam.Name == name {
+			return route
+		}
+	}
+
+	route.PathParams = append(route.PathParams, astra.Param{
+		Field: astra.Field{
+			Type: "string",
+		},
+		Name:       name,
+		IsRequired: true,
+	})
+
+	return route
+}
+
+// takesHandlerArguments checks whether a function signature accepts a http.ResponseWriter or *http.Request.
+func takesHandlerArguments(signature *types.Signature) bool {
+	for i := 0; i < signature.Params().Len(); i++ {
+		paramType := signature.Params().At(i).Type().String()
+		if paramType == HTTPPackagePath+"."+ResponseWriterType || paramType == "*"+HTTPPackagePath+"."+RequestType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addComponent(s *astra.Service, currRoute *astra.Route) func(astTraversal.Result) error {
+	return func(result astTraversal.Result) error {
+		field := astra.ParseResultToField(result)
+
+		if field.Package != "" {
+			s.AddComponent(currRoute, field)
+		}
+		return nil
+	}
+}
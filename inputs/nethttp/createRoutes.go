@@ -0,0 +1,179 @@
+package nethttp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/utils"
+)
+
+// foundRoute is a route found from a ServeMux pattern, either by looking it up on the ServeMux or by finding it in the source code.
+type foundRoute struct {
+	method      string
+	path        string
+	file        string
+	line        int
+	handlerName string
+}
+
+// CreateRoutes creates routes from a net/http ServeMux.
+// It will only create the routes and refer to the handler function by name, file and line number.
+// The routes will be populated later by parseRoutes.
+// The ServeMux doesn't expose its registered patterns, so they either have to be passed in, where each is looked up on the ServeMux to find its handler,
+// or if no patterns are passed in, they are discovered statically by finding the calls to HandleFunc and Handle in the source code of the working directory.
+// It will individually call handlerroute.CreateRoute for each route.
+func CreateRoutes(mux *http.ServeMux, patterns []string) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Debug().Msg("Populating service with net/http routes")
+
+		var routes []foundRoute
+		var err error
+		if len(patterns) > 0 {
+			routes, err = lookupRoutes(s, mux, patterns)
+		} else {
+			routes, err = discoverRoutes(s)
+		}
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to find net/http routes")
+			return err
+		}
+
+		for _, route := range routes {
+			s.Log.Debug().Str("path", route.path).Str("method", route.method).Msg("Populating route")
+
+			denied := false
+			for _, denyFunc := range s.PathDenyList {
+				if denyFunc(route.path) {
+					s.Log.Debug().Str("path", route.path).Str("method", route.method).Msg("Path is blacklisted")
+					denied = true
+					break
+				}
+			}
+			if denied {
+				continue
+			}
+
+			s.Log.Debug().Str("path", route.path).Str("method", route.method).Str("file", route.file).Int("line", route.line).Msg("Parsing route")
+			err := handlerroute.CreateRoute(s, route.file, route.line, route.method, route.path, route.handlerName)
+			if err != nil {
+				s.Log.Error().Str("path", route.path).Str("method", route.method).Str("file", route.file).Int("line", route.line).Err(err).Msg("Failed to parse route")
+				return err
+			}
+		}
+		s.Log.Debug().Msg("Populated service with net/http routes")
+
+		return nil
+	}
+}
+
+// lookupRoutes finds the handler for each of the patterns by looking them up on the ServeMux.
+func lookupRoutes(s *astra.Service, mux *http.ServeMux, patterns []string) ([]foundRoute, error) {
+	if mux == nil {
+		return nil, errors.New("patterns were specified without a ServeMux")
+	}
+
+	locator := utils.NewHandlerLocator(s.WorkDir)
+
+	routes := make([]foundRoute, 0, len(patterns))
+	for _, pattern := range patterns {
+		method, host, path := splitPattern(pattern)
+
+		// A request is made up that matches the pattern, with each of the wildcards filled in.
+		req := &http.Request{
+			Method: method,
+			Host:   host,
+			URL:    &url.URL{Path: examplePath(path)},
+		}
+		if req.Method == "" {
+			req.Method = http.MethodGet
+		}
+
+		handler, matchedPattern := mux.Handler(req)
+		if matchedPattern == "" {
+			return nil, fmt.Errorf("pattern not registered on the ServeMux: %s", pattern)
+		}
+
+		// Only function handlers (i.e. http.HandlerFunc) can be located, handlers implementing http.Handler with a struct are skipped.
+		handlerValue := reflect.ValueOf(handler)
+		if handlerValue.Kind() != reflect.Func {
+			s.Log.Warn().Str("pattern", pattern).Str("handlerType", handlerValue.Type().String()).Msg("Handler is not a function, skipping")
+			continue
+		}
+
+		pc := handlerValue.Pointer()
+		handlerFunc := runtime.FuncForPC(pc)
+		file, line := handlerFunc.FileLine(pc)
+
+		// A method value is wrapped in a function that the compiler generates, so it has no position of its own
+		if utils.SplitHandlerPath(handlerFunc.Name()).Receiver() != "" {
+			var err error
+			file, line, err = locator.Find(handlerFunc.Name())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		s.Log.Debug().Str("pattern", pattern).Str("file", file).Int("line", line).Msg("Found route handler")
+
+		routes = append(routes, foundRoute{
+			method:      routeMethod(method),
+			path:        routePath(path),
+			file:        file,
+			line:        line,
+			handlerName: handlerFunc.Name(),
+		})
+	}
+
+	return routes, nil
+}
+
+// splitPattern splits a ServeMux pattern (i.e. "GET example.com/posts/{id}") into its method, host and path.
+func splitPattern(pattern string) (method string, host string, path string) {
+	pattern = strings.TrimSpace(pattern)
+
+	if index := strings.IndexAny(pattern, " \t"); index != -1 {
+		method = pattern[:index]
+		pattern = strings.TrimLeft(pattern[index:], " \t")
+	}
+
+	if index := strings.Index(pattern, "/"); index != -1 {
+		host = pattern[:index]
+		path = pattern[index:]
+	}
+
+	return method, host, path
+}
+
+// routeMethod returns the method of the route for a pattern.
+// Patterns without a method match every method, so they are documented as GET.
+func routeMethod(method string) string {
+	if method == "" {
+		return http.MethodGet
+	}
+
+	return method
+}
+
+// routePath returns the path of the route for a pattern.
+// The {$} wildcard only anchors the end of the path, so it is removed.
+func routePath(path string) string {
+	return strings.ReplaceAll(path, "{$}", "")
+}
+
+// examplePath returns a path that matches the pattern path, with each of the wildcards filled in.
+func examplePath(path string) string {
+	return regexp.MustCompile(`\{[^/]*\}`).ReplaceAllStringFunc(path, func(wildcard string) string {
+		if wildcard == "{$}" {
+			return ""
+		}
+		return "x"
+	})
+}
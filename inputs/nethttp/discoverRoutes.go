@@ -0,0 +1,159 @@
+package nethttp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/utils"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// discoverRoutes finds the routes by statically finding the calls to HandleFunc and Handle (on a ServeMux or the default ServeMux) in the source code of the working directory.
+// The pattern has to be a constant string, and the handler has to be a function declaration or function literal (optionally converted to a http.HandlerFunc).
+func discoverRoutes(s *astra.Service) ([]foundRoute, error) {
+	workDir := s.WorkDir
+	if workDir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		workDir = cwd
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:  workDir,
+		Fset: fset,
+	}, "./...")
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]foundRoute, 0)
+	for _, pkg := range pkgs {
+		for _, pkgErr := range pkg.Errors {
+			s.Log.Warn().Str("package", pkg.PkgPath).Err(pkgErr).Msg("Package contains errors")
+		}
+
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+				if !ok || funcDecl.Body == nil {
+					continue
+				}
+
+				ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+					callExpr, ok := n.(*ast.CallExpr)
+					if !ok || len(callExpr.Args) != 2 {
+						return true
+					}
+
+					if !isHandleCall(pkg.TypesInfo, callExpr) {
+						return true
+					}
+
+					patternValue := pkg.TypesInfo.Types[callExpr.Args[0]].Value
+					if patternValue == nil || patternValue.Kind() != constant.String {
+						s.Log.Warn().Str("position", fset.Position(callExpr.Pos()).String()).Msg("Pattern is not a constant string, skipping")
+						return true
+					}
+					pattern := constant.StringVal(patternValue)
+
+					handlerName, handlerPos, err := findHandler(pkg, funcDecl, callExpr.Args[1])
+					if err != nil {
+						s.Log.Warn().Str("pattern", pattern).Err(err).Msg("Handler could not be located, skipping")
+						return true
+					}
+
+					position := fset.Position(handlerPos)
+					s.Log.Debug().Str("pattern", pattern).Str("file", position.Filename).Int("line", position.Line).Msg("Found route handler")
+
+					method, _, path := splitPattern(pattern)
+					routes = append(routes, foundRoute{
+						method:      routeMethod(method),
+						path:        routePath(path),
+						file:        position.Filename,
+						line:        position.Line,
+						handlerName: handlerName,
+					})
+
+					return true
+				})
+			}
+		}
+	}
+
+	// The packages aren't guaranteed to be loaded in a consistent order, so we sort the routes to keep the output consistent.
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].path == routes[j].path {
+			return routes[i].method < routes[j].method
+		}
+		return routes[i].path < routes[j].path
+	})
+
+	return routes, nil
+}
+
+// isHandleCall checks whether the call expression is a call to HandleFunc or Handle from the net/http package.
+// This includes both the ServeMux methods and the package level functions that use the default ServeMux.
+func isHandleCall(info *types.Info, callExpr *ast.CallExpr) bool {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	funcType, ok := info.Uses[selectorExpr.Sel].(*types.Func)
+	if !ok || funcType.Pkg() == nil || funcType.Pkg().Path() != HTTPPackagePath {
+		return false
+	}
+
+	return funcType.Name() == "HandleFunc" || funcType.Name() == "Handle"
+}
+
+// findHandler finds the name and position of the handler passed to HandleFunc or Handle.
+// The name follows the same format as the runtime function names, so it can be used in the same way as the other inputs.
+func findHandler(pkg *packages.Package, funcDecl *ast.FuncDecl, expr ast.Expr) (string, token.Pos, error) {
+	// Unwrap any conversion to a http.HandlerFunc
+	if callExpr, ok := expr.(*ast.CallExpr); ok && len(callExpr.Args) == 1 && pkg.TypesInfo.Types[callExpr.Fun].IsType() {
+		expr = callExpr.Args[0]
+	}
+
+	switch handler := expr.(type) {
+	case *ast.FuncLit:
+		return utils.ClosureName(pkg.PkgPath, funcDecl, handler), handler.Pos(), nil
+	case *ast.Ident, *ast.SelectorExpr:
+		var ident *ast.Ident
+		if selectorExpr, ok := handler.(*ast.SelectorExpr); ok {
+			ident = selectorExpr.Sel
+		} else {
+			ident = handler.(*ast.Ident)
+		}
+
+		funcType, ok := pkg.TypesInfo.Uses[ident].(*types.Func)
+		if !ok {
+			return "", token.NoPos, fmt.Errorf("handler is not a function: %s", ident.Name)
+		}
+
+		// The position of the function name is on the same line as the func keyword of its declaration
+		if !funcType.Pos().IsValid() {
+			return "", token.NoPos, fmt.Errorf("could not find function declaration: %s", funcType.FullName())
+		}
+
+		// A method value (i.e. h.getPets) is named after the type of its receiver
+		if funcType.Type().(*types.Signature).Recv() != nil {
+			return utils.MethodValueName(funcType.Pkg().Path(), funcType), funcType.Pos(), nil
+		}
+
+		return fmt.Sprintf("%s.%s", funcType.Pkg().Path(), funcType.Name()), funcType.Pos(), nil
+	}
+
+	return "", token.NoPos, fmt.Errorf("unsupported handler expression: %T", expr)
+}
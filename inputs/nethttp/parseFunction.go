@@ -0,0 +1,46 @@
+package nethttp
+
+import (
+	"go/types"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/inputs/internal/handlerroute"
+	"github.com/ls6-events/astra/inputs/internal/httphandler"
+)
+
+const (
+	// HTTPPackagePath is the import path of the net/http package.
+	HTTPPackagePath = httphandler.HTTPPackagePath
+	// JSONPackagePath is the import path of the encoding/json package.
+	JSONPackagePath = httphandler.JSONPackagePath
+	// ResponseWriterType is the type of the response writer variable.
+	ResponseWriterType = httphandler.ResponseWriterType
+	// ResponseWriterIsPointer is whether the response writer variable is a pointer for the handler functions.
+	ResponseWriterIsPointer = httphandler.ResponseWriterIsPointer
+	// RequestType is the type of the request variable.
+	RequestType = httphandler.RequestType
+	// RequestIsPointer is whether the request variable is a pointer for the handler functions.
+	RequestIsPointer = httphandler.RequestIsPointer
+)
+
+// router reads the path params from r.PathValue, which takes the name of the path param.
+var router = httphandler.Router{
+	PackagePath: HTTPPackagePath,
+	PathParamArg: func(funcType *types.Func, signature *types.Signature) (int, bool) {
+		return 0, signature.Recv() != nil && signature.Recv().Type().String() == "*"+HTTPPackagePath+"."+RequestType && funcType.Name() == "PathValue"
+	},
+}
+
+// ParseRoutes parses routes from net/http routes.
+// It will populate the routes with the handler function, which is parsed by parseFunction.
+// CreateRoutes must be called before this.
+func ParseRoutes() astra.ServiceFunction {
+	return handlerroute.ParseRoutes("net/http", parseFunction)
+}
+
+// parseFunction parses a function and adds it to the service.
+// The path params are read from r.PathValue, as they are matched by the ServeMux patterns.
+func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error {
+	return httphandler.ParseFunction(s, funcTraverser, currRoute, activeFile, level, router)
+}
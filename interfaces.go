@@ -0,0 +1,38 @@
+package astra
+
+import "github.com/ls6-events/astra/astTraversal"
+
+// WithInterfaceImplementations registers the concrete types that implement a named interface, so the interface is described as one of them.
+// The interface and implementations are referred to by their full name (i.e. github.com/org/events.Event and github.com/org/events.UserCreated).
+func WithInterfaceImplementations(iface string, implementations ...string) Option {
+	return func(s *Service) {
+		if s.Interfaces.Implementations == nil {
+			s.Interfaces.Implementations = make(map[string][]string)
+		}
+
+		s.Interfaces.Implementations[iface] = append(s.Interfaces.Implementations[iface], implementations...)
+	}
+}
+
+// WithInterfaceDiscovery finds the implementations of the named interfaces that haven't been registered, from the packages in the main module.
+// Every package in the module is loaded to find them, which can be slow for a large module.
+func WithInterfaceDiscovery() Option {
+	return func(s *Service) {
+		s.Interfaces.Discover = true
+	}
+}
+
+// WithInterfaceDiscriminator sets the property that tells the implementations of a named interface apart, where the value for each implementation is the constant returned by its method.
+// Otherwise, the discriminator is taken from the field of the implementations with the discriminator struct tag (i.e. `json:"type" discriminator:"user.created"`).
+func WithInterfaceDiscriminator(iface string, property string, method string) Option {
+	return func(s *Service) {
+		if s.Interfaces.Discriminators == nil {
+			s.Interfaces.Discriminators = make(map[string]astTraversal.InterfaceDiscriminator)
+		}
+
+		s.Interfaces.Discriminators[iface] = astTraversal.InterfaceDiscriminator{
+			Property: property,
+			Method:   method,
+		}
+	}
+}
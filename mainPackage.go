@@ -80,7 +80,10 @@ func (s *Service) cleanupTempMainPackage() error {
 }
 
 // GetMainPackageName returns the name of the temporary main package.
+// It is safe to call concurrently, the temporary main package will only be set up once.
 func (s *Service) GetMainPackageName() (string, error) {
+	defer s.lock()()
+
 	if s.tempMainPackageName == "" {
 		err := s.setupTempMainPackage()
 		if err != nil {
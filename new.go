@@ -2,6 +2,7 @@ package astra
 
 import (
 	"os"
+	"sync"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -12,7 +13,9 @@ import (
 // It takes in a list of options that can be used to configure the generator.
 // It will also setup the logger for the generator and setup the slices that are used to store the routes, inputs, outputs and components.
 func New(opts ...Option) *Service {
-	s := &Service{}
+	s := &Service{
+		mu: &sync.Mutex{},
+	}
 
 	//nolint:reassign // This is needed to set the stack trace marshaling function.
 	zerolog.ErrorStackMarshaler = pkgerrors.MarshalStack
@@ -6,3 +6,12 @@ func WithCustomWorkDir(wd string) Option {
 		s.WorkDir = wd
 	}
 }
+
+// WithConcurrency is an option to set the number of routes that are parsed at the same time.
+// Each route is parsed with its own traverser, so this can speed up the generation for large projects.
+// The output is the same regardless of the concurrency.
+func WithConcurrency(n int) Option {
+	return func(s *Service) {
+		s.Concurrency = n
+	}
+}
@@ -14,3 +14,13 @@ func TestWithCustomWorkDir(t *testing.T) {
 
 	require.Equal(t, "/test-work-dir", service.WorkDir)
 }
+
+func TestWithConcurrency(t *testing.T) {
+	service := &Service{}
+
+	require.Equal(t, 0, service.Concurrency)
+
+	WithConcurrency(4)(service)
+
+	require.Equal(t, 4, service.Concurrency)
+}
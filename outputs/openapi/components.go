@@ -138,40 +138,72 @@ func componentToSchema(service *astra.Service, component astra.Field, bindingTyp
 		return mapTypeFormat(service, component.Name, component.Package), true
 	}
 
+	// A named interface with known implementations is one of them
+	if len(component.Implementations) > 0 {
+		return implementationsToSchema(component, bindingType)
+	}
+
+	// A type that implements json.Marshaler can't be described by its fields, so the fallback is used unless it has a type mapping
+	if component.Marshaler == astTraversal.JSONMarshaler {
+		return Schema{
+			Type:   service.JSONMarshalerFallback.Type,
+			Format: service.JSONMarshalerFallback.Format,
+		}, true
+	}
+
 	if component.Type == "struct" {
+		resolved, fieldsBound := resolveStructFields(service, component, bindingType)
+		if !fieldsBound {
+			return Schema{}, false
+		}
+
 		embeddedProperties := make([]Schema, 0)
 		schema = Schema{
 			Type:       "object",
 			Properties: make(map[string]Schema),
 		}
-		for _, field := range component.StructFields {
-			// We should aim to use doc comments in the future.
-			// However https://github.com/OAI/OpenAPI-Specification/issues/1514.
-			if field.IsEmbedded {
-				componentRef, componentBound := makeComponentRef(bindingType, field.Type, field.Package)
-				if componentBound {
-					embeddedProperties = append(embeddedProperties, Schema{
-						Ref: componentRef,
-					})
+
+		// An embedded struct is referenced, unless some of its fields are hidden by other fields with the same name
+		// In that case, the fields that are promoted from it are added to the struct itself, so the schema matches the fields that are shown
+		inlined := make(map[string]bool)
+		for _, embeddedKey := range resolved.embedded {
+			field := component.StructFields[embeddedKey]
+
+			if embeddedComponent, ok := findEmbeddedStruct(service, field, bindingType); ok {
+				embeddedResolved, _ := resolveStructFields(service, embeddedComponent, bindingType)
+
+				promotedCount := 0
+				for _, promoted := range resolved.fields {
+					if promoted.embeddedIn == embeddedKey {
+						promotedCount++
+					}
 				}
 
-				continue
-			}
+				if promotedCount == len(embeddedResolved.fields) {
+					componentRef, componentBound := makeComponentRef(bindingType, field.Type, field.Package)
+					if componentBound {
+						embeddedProperties = append(embeddedProperties, Schema{
+							Ref: componentRef,
+						})
 
-			fieldBinding := field.StructFieldBindingTags[bindingType]
-			fieldNoBinding := field.StructFieldBindingTags[astTraversal.NoBindingTag]
-			if fieldBinding == (astTraversal.BindingTag{}) && fieldNoBinding == (astTraversal.BindingTag{}) {
-				return Schema{}, false
+						continue
+					}
+				}
 			}
-			if fieldBinding == (astTraversal.BindingTag{}) {
-				fieldBinding = fieldNoBinding
+
+			inlined[embeddedKey] = true
+		}
+
+		for name, promoted := range resolved.fields {
+			if promoted.embeddedIn != "" && !inlined[promoted.embeddedIn] {
+				continue
 			}
 
-			if !fieldBinding.NotShown {
-				fieldSchema, fieldBound := componentToSchema(service, field, bindingType)
-				if fieldBound {
-					schema.Properties[fieldBinding.Name] = fieldSchema
-				}
+			// We should aim to use doc comments in the future.
+			// However https://github.com/OAI/OpenAPI-Specification/issues/1514.
+			fieldSchema, fieldBound := structFieldToSchema(service, promoted.field, promoted.binding, bindingType)
+			if fieldBound {
+				schema.Properties[name] = fieldSchema
 			}
 		}
 
@@ -244,6 +276,70 @@ func componentToSchema(service *astra.Service, component astra.Field, bindingTyp
 			}
 		} else {
 			schema.Enum = component.EnumValues
+			schema.XEnumVarNames = component.EnumNames
+		}
+	}
+
+	return schema, true
+}
+
+// structFieldToSchema converts a field of a struct to the schema of its property, with its validation tags, binding tag options and doc metadata.
+func structFieldToSchema(service *astra.Service, field astra.Field, fieldBinding astTraversal.BindingTag, bindingType astTraversal.BindingTagType) (Schema, bool) {
+	fieldSchema, fieldBound := componentToSchema(service, field, bindingType)
+	if !fieldBound {
+		return Schema{}, false
+	}
+
+	fieldSchema = applyValidationTags(service, fieldSchema, field)
+
+	// The ,string option encodes a number or boolean as a string in JSON
+	if bindingType == astTraversal.JSONBindingTag && fieldBinding.HasOption("string") {
+		fieldSchema = stringEncodedSchema(fieldSchema)
+	}
+
+	fieldSchema = applyDocMetadata(fieldSchema, field)
+
+	if field.IsPointer {
+		fieldSchema = nullableSchema(fieldSchema)
+	}
+
+	return fieldSchema, true
+}
+
+// implementationsToSchema converts a named interface to a oneOf of its implementations, with the discriminator if it has one.
+// It isn't bound for a binding type that none of its implementations are bound for.
+func implementationsToSchema(component astra.Field, bindingType astTraversal.BindingTagType) (Schema, bool) {
+	schema := Schema{
+		OneOf: make([]Schema, 0, len(component.Implementations)),
+	}
+
+	mapping := make(map[string]string)
+	for _, implementation := range component.Implementations {
+		componentRef, componentBound := makeComponentRef(bindingType, implementation.Type, implementation.Package)
+		if !componentBound {
+			continue
+		}
+
+		schema.OneOf = append(schema.OneOf, Schema{
+			Ref: componentRef,
+		})
+
+		if implementation.DiscriminatorValue != "" {
+			mapping[implementation.DiscriminatorValue] = componentRef
+		}
+	}
+
+	if len(schema.OneOf) == 0 {
+		return Schema{}, false
+	}
+
+	if component.Discriminator != "" {
+		schema.Discriminator = &Discriminator{
+			PropertyName: component.Discriminator,
+		}
+
+		if len(mapping) > 0 {
+			schema.Discriminator.Mapping = mapping
 		}
 	}
 
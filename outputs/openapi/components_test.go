@@ -103,3 +103,80 @@ func TestMakeCollisionSafeNamesFromComponents(t *testing.T) {
 	// Clean up by resetting the collisionSafeNames map
 	collisionSafeNames = make(map[string]string)
 }
+
+func TestComponentToSchema_JSONMarshaler(t *testing.T) {
+	component := astra.Field{
+		Name:      "Timestamp",
+		Package:   "github.com/example/package1",
+		Type:      "any",
+		Marshaler: astTraversal.JSONMarshaler,
+	}
+
+	t.Run("uses the fallback", func(t *testing.T) {
+		service := astra.New(astra.WithJSONMarshalerFallback("string", ""))
+
+		schema, bound := componentToSchema(service, component, astTraversal.NoBindingTag)
+		assert.True(t, bound)
+		assert.Equal(t, Schema{Type: "string"}, schema)
+	})
+
+	t.Run("uses the custom type mapping over the fallback", func(t *testing.T) {
+		service := astra.New(astra.WithJSONMarshalerFallback("string", ""), astra.WithCustomTypeMappingSingle("github.com/example/package1.Timestamp", "integer", "int64"))
+
+		schema, bound := componentToSchema(service, component, astTraversal.NoBindingTag)
+		assert.True(t, bound)
+		assert.Equal(t, Schema{Type: "integer", Format: "int64"}, schema)
+	})
+}
+
+func TestComponentToSchema_Implementations(t *testing.T) {
+	collisionSafeNames = make(map[string]string)
+	collisionSafeNames[collisionSafeKey(astTraversal.NoBindingTag, "Circle", "shapes")] = "shapes.Circle"
+	collisionSafeNames[collisionSafeKey(astTraversal.NoBindingTag, "Square", "shapes")] = "shapes.Square"
+
+	component := astra.Field{
+		Name:    "Shape",
+		Package: "shapes",
+		Type:    "any",
+		Implementations: []astra.Field{
+			{Type: "Circle", Package: "shapes", DiscriminatorValue: "circle"},
+			{Type: "Square", Package: "shapes", DiscriminatorValue: "square"},
+		},
+	}
+
+	t.Run("uses oneOf the implementations", func(t *testing.T) {
+		schema, bound := componentToSchema(astra.New(), component, astTraversal.NoBindingTag)
+		assert.True(t, bound)
+		assert.Equal(t, Schema{
+			OneOf: []Schema{
+				{Ref: "#/components/schemas/shapes.Circle"},
+				{Ref: "#/components/schemas/shapes.Square"},
+			},
+		}, schema)
+	})
+
+	t.Run("adds the discriminator with its mapping", func(t *testing.T) {
+		component.Discriminator = "kind"
+
+		schema, bound := componentToSchema(astra.New(), component, astTraversal.NoBindingTag)
+		assert.True(t, bound)
+		assert.Equal(t, &Discriminator{
+			PropertyName: "kind",
+			Mapping: map[string]string{
+				"circle": "#/components/schemas/shapes.Circle",
+				"square": "#/components/schemas/shapes.Square",
+			},
+		}, schema.Discriminator)
+	})
+	t.Run("isn't bound if none of the implementations are", func(t *testing.T) {
+		_, bound := componentToSchema(astra.New(), astra.Field{
+			Name:    "Shape",
+			Package: "shapes",
+			Type:    "any",
+			Implementations: []astra.Field{
+				{Type: "Triangle", Package: "shapes"},
+			},
+		}, astTraversal.NoBindingTag)
+		assert.False(t, bound)
+	})
+}
@@ -0,0 +1,55 @@
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ls6-events/astra"
+)
+
+// setCookieHeader is the name of the response header that sets cookies.
+const setCookieHeader = "Set-Cookie"
+
+// cookieAttributes formats a cookie as it is set in the Set-Cookie header, with the attributes that are known (i.e. session=...; Path=/; HttpOnly).
+func cookieAttributes(cookie astra.Cookie) string {
+	name := cookie.Name
+	if name == "" {
+		name = "<name>"
+	}
+
+	attributes := []string{name + "=<value>"}
+	if cookie.Path != "" {
+		attributes = append(attributes, "Path="+cookie.Path)
+	}
+	if cookie.Domain != "" {
+		attributes = append(attributes, "Domain="+cookie.Domain)
+	}
+	if cookie.MaxAge > 0 {
+		attributes = append(attributes, fmt.Sprintf("Max-Age=%d", cookie.MaxAge))
+	} else if cookie.MaxAge < 0 {
+		attributes = append(attributes, "Max-Age=0")
+	}
+	if cookie.HTTPOnly {
+		attributes = append(attributes, "HttpOnly")
+	}
+	if cookie.Secure {
+		attributes = append(attributes, "Secure")
+	}
+
+	return strings.Join(attributes, "; ")
+}
+
+// mapCookiesToHeader converts the cookies that are set by a route to the Set-Cookie header, which describes each of them.
+func mapCookiesToHeader(cookies []astra.Cookie) Header {
+	descriptions := make([]string, 0, len(cookies))
+	for _, cookie := range cookies {
+		descriptions = append(descriptions, "`"+cookieAttributes(cookie)+"`")
+	}
+
+	return Header{
+		Description: "Sets the cookies: " + strings.Join(descriptions, ", "),
+		Schema: Schema{
+			Type: "string",
+		},
+	}
+}
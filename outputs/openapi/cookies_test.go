@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCookieAttributes(t *testing.T) {
+	t.Run("it formats the known attributes", func(t *testing.T) {
+		require.Equal(t, "session=<value>; Path=/; Domain=example.com; Max-Age=3600; HttpOnly; Secure", cookieAttributes(astra.Cookie{
+			Name:     "session",
+			Path:     "/",
+			Domain:   "example.com",
+			MaxAge:   3600,
+			Secure:   true,
+			HTTPOnly: true,
+		}))
+	})
+
+	t.Run("it expires a cookie with a negative max age", func(t *testing.T) {
+		require.Equal(t, "session=<value>; Path=/; Max-Age=0", cookieAttributes(astra.Cookie{
+			Name:   "session",
+			Path:   "/",
+			MaxAge: -1,
+		}))
+	})
+
+	t.Run("it formats a cookie without a known name", func(t *testing.T) {
+		require.Equal(t, "<name>=<value>", cookieAttributes(astra.Cookie{}))
+	})
+}
+
+func TestMapCookiesToHeader(t *testing.T) {
+	header := mapCookiesToHeader([]astra.Cookie{
+		{Name: "session", Path: "/", HTTPOnly: true},
+		{Name: "theme", Path: "/"},
+	})
+
+	require.Equal(t, Header{
+		Description: "Sets the cookies: `session=<value>; Path=/; HttpOnly`, `theme=<value>; Path=/`",
+		Schema: Schema{
+			Type: "string",
+		},
+	}, header)
+}
@@ -3,6 +3,7 @@ package openapi
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
 	"path"
@@ -44,10 +45,11 @@ func Generate(filePath string) astra.ServiceFunction {
 			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Msg("Generating endpoint")
 
 			endpoint.Path = utils.MapPathParams(endpoint.Path, func(param string) string {
-				if param[0] == ':' {
-					return fmt.Sprintf("{%s}", param[1:])
+				name, isRequired := utils.ParsePathParam(param)
+				if isRequired {
+					return fmt.Sprintf("{%s}", name)
 				} else {
-					return fmt.Sprintf("{%s*}", param[1:])
+					return fmt.Sprintf("{%s*}", name)
 				}
 			})
 
@@ -62,6 +64,9 @@ func Generate(filePath string) astra.ServiceFunction {
 					continue
 				}
 
+				// A path param that is typed from a bound struct has the constraints of its validation tags
+				schema = applyValidationTags(s, schema, pathParam.Field)
+
 				operation.Parameters = append(operation.Parameters, Parameter{
 					Name:     pathParam.Name,
 					In:       "path",
@@ -87,6 +92,21 @@ func Generate(filePath string) astra.ServiceFunction {
 				operation.Parameters = append(operation.Parameters, parameter)
 			}
 
+			for _, requestCookie := range endpoint.RequestCookies {
+				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", requestCookie.Name).Msg("Adding request cookie")
+				schema, bound := mapParamToSchema(astTraversal.NoBindingTag, requestCookie)
+				if !bound {
+					continue
+				}
+
+				operation.Parameters = append(operation.Parameters, Parameter{
+					Name:     requestCookie.Name,
+					In:       "cookie",
+					Required: requestCookie.IsRequired,
+					Schema:   schema,
+				})
+			}
+
 			for _, queryParam := range endpoint.QueryParams {
 				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", queryParam.Name).Msg("Adding query parameter")
 				schema, bound := mapParamToSchema(astTraversal.FormBindingTag, queryParam)
@@ -110,12 +130,13 @@ func Generate(filePath string) astra.ServiceFunction {
 						style, explode := getQueryParamStyle(propertySchema)
 
 						parameter := Parameter{
-							Name:     propertyName,
-							In:       "query",
-							Required: queryParam.IsRequired,
-							Explode:  explode,
-							Style:    style,
-							Schema:   propertySchema,
+							Name:       propertyName,
+							In:         "query",
+							Required:   queryParam.IsRequired,
+							Deprecated: propertySchema.Deprecated,
+							Explode:    explode,
+							Style:      style,
+							Schema:     propertySchema,
 						}
 
 						operation.Parameters = append(operation.Parameters, parameter)
@@ -166,7 +187,7 @@ func Generate(filePath string) astra.ServiceFunction {
 			}
 
 			var responseHeaders map[string]Header
-			if len(endpoint.ResponseHeaders) > 0 {
+			if len(endpoint.ResponseHeaders) > 0 || len(endpoint.ResponseCookies) > 0 {
 				responseHeaders = make(map[string]Header)
 				for _, responseHeader := range endpoint.ResponseHeaders {
 					s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", responseHeader.Name).Msg("Adding response header")
@@ -178,6 +199,12 @@ func Generate(filePath string) astra.ServiceFunction {
 						}
 					}
 				}
+
+				// All the cookies are set with the one header, so they're described together
+				if len(endpoint.ResponseCookies) > 0 {
+					s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Int("cookies", len(endpoint.ResponseCookies)).Msg("Adding response cookies")
+					responseHeaders[setCookieHeader] = mapCookiesToHeader(endpoint.ResponseCookies)
+				}
 			}
 
 			for _, returnType := range endpoint.ReturnTypes {
@@ -200,13 +227,39 @@ func Generate(filePath string) astra.ServiceFunction {
 				}
 
 				if !reflect.DeepEqual(mediaType, MediaType{}) {
+					// The events of a stream can be of different types, so the stream is one of the types of its events
+					if existing, ok := operation.Responses[statusCode].Content[returnType.ContentType]; ok && returnType.IsStream {
+						mediaType.Schema = oneOfSchemas(existing.Schema, mediaType.Schema)
+					}
+
 					operation.Responses[statusCode].Content[returnType.ContentType] = mediaType
 				}
+
+				// The headers of the return type are only added to its own response, alongside the headers of the route
+				if len(returnType.Headers) > 0 {
+					response := operation.Responses[statusCode]
+
+					headers := make(map[string]Header, len(response.Headers)+len(returnType.Headers))
+					maps.Copy(headers, response.Headers)
+					for _, header := range returnType.Headers {
+						schema, bound := mapParamToSchema(astTraversal.HeaderBindingTag, header)
+						if bound {
+							headers[header.Name] = Header{
+								Schema:   schema,
+								Required: header.IsRequired,
+							}
+						}
+					}
+
+					response.Headers = headers
+					operation.Responses[statusCode] = response
+				}
 			}
 
 			if endpoint.Doc != "" {
 				operation.Description = endpoint.Doc
 			}
+			operation.Deprecated = endpoint.Deprecated
 
 			if endpoint.OperationID != "" {
 				operation.OperationID = endpoint.OperationID
@@ -262,6 +315,7 @@ func Generate(filePath string) astra.ServiceFunction {
 				if component.Doc != "" {
 					schema.Description = component.Doc
 				}
+				schema = applyDocMetadata(schema, component)
 
 				componentName, bound := makeComponentRefName(bindingType, component.Name, component.Package)
 				if bound {
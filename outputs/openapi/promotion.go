@@ -0,0 +1,207 @@
+package openapi
+
+import (
+	"slices"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// promotedField is a field of a struct, which can be promoted from one of its embedded structs.
+type promotedField struct {
+	field   astra.Field
+	binding astTraversal.BindingTag
+	// depth is the number of embedded structs the field is promoted through (0 for a field of the struct itself)
+	depth int
+	// embeddedIn is the key of the embedded field of the struct that the field is promoted from (empty for a field of the struct itself)
+	embeddedIn string
+}
+
+// resolvedStruct is the fields of a struct after the fields of its embedded structs are promoted.
+type resolvedStruct struct {
+	// fields are the fields that are shown, by their name
+	fields map[string]promotedField
+	// embedded are the keys of the embedded fields of the struct whose fields are promoted, in order
+	embedded []string
+}
+
+// embeddedStruct is a struct that is embedded at a depth, waiting for its fields to be promoted.
+type embeddedStruct struct {
+	component  astra.Field
+	embeddedIn string
+	// count is the number of times the struct is embedded at the same depth
+	count int
+}
+
+// fieldBindingTag gets the binding tag of a struct field for the binding type, or the tag without a binding if it doesn't have one.
+// The field isn't bound if it has neither.
+func fieldBindingTag(field astra.Field, bindingType astTraversal.BindingTagType) (astTraversal.BindingTag, bool) {
+	fieldBinding := field.StructFieldBindingTags[bindingType]
+	if fieldBinding == (astTraversal.BindingTag{}) {
+		fieldBinding = field.StructFieldBindingTags[astTraversal.NoBindingTag]
+	}
+
+	return fieldBinding, fieldBinding != (astTraversal.BindingTag{})
+}
+
+// findEmbeddedStruct finds the component of an embedded field whose fields are promoted.
+// As in encoding/json, an embedded struct with a name in its tag isn't promoted, but is a field with that name.
+func findEmbeddedStruct(service *astra.Service, field astra.Field, bindingType astTraversal.BindingTagType) (astra.Field, bool) {
+	if !field.IsEmbedded {
+		return astra.Field{}, false
+	}
+
+	if fieldBinding, _ := fieldBindingTag(field, bindingType); fieldBinding.IsNamed {
+		return astra.Field{}, false
+	}
+
+	component, found := findComponentByPackageAndType(service.Components, field.Package, field.Type)
+	if !found || component.Type != "struct" {
+		return astra.Field{}, false
+	}
+
+	return component, true
+}
+
+// structFieldsBound checks that all the fields of a struct, other than the promoted embedded structs, are bound for the binding type.
+func structFieldsBound(service *astra.Service, component astra.Field, bindingType astTraversal.BindingTagType) bool {
+	for _, field := range component.StructFields {
+		if _, promoted := findEmbeddedStruct(service, field, bindingType); promoted {
+			continue
+		}
+
+		if _, bound := fieldBindingTag(field, bindingType); !bound {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveStructFields finds the fields of a struct that are shown, with the same field resolution as encoding/json.
+// The fields of embedded structs are promoted into the struct, level by level, where each embedded struct is only promoted from its shallowest depth.
+// If more than one field has the same name, the shallowest one is shown, then the one with the name in its tag, otherwise none of them are shown.
+// An embedded struct whose fields aren't all bound for the binding type is left out, and the struct itself isn't bound if its own fields aren't.
+func resolveStructFields(service *astra.Service, component astra.Field, bindingType astTraversal.BindingTagType) (resolvedStruct, bool) {
+	if !structFieldsBound(service, component, bindingType) {
+		return resolvedStruct{}, false
+	}
+
+	resolved := resolvedStruct{
+		fields:   make(map[string]promotedField),
+		embedded: make([]string, 0),
+	}
+
+	candidates := make(map[string][]promotedField)
+	visited := make(map[string]struct{})
+	current := []embeddedStruct{{component: component, count: 1}}
+	for depth := 0; len(current) > 0; depth++ {
+		next := make([]embeddedStruct, 0)
+		nextIndex := make(map[string]int)
+
+		for _, embedded := range current {
+			key := embedded.component.Package + "." + embedded.component.Name
+			if _, ok := visited[key]; ok {
+				continue
+			}
+			visited[key] = struct{}{}
+
+			if depth > 0 && !structFieldsBound(service, embedded.component, bindingType) {
+				continue
+			}
+
+			fieldKeys := make([]string, 0, len(embedded.component.StructFields))
+			for fieldKey := range embedded.component.StructFields {
+				fieldKeys = append(fieldKeys, fieldKey)
+			}
+			slices.Sort(fieldKeys)
+
+			for _, fieldKey := range fieldKeys {
+				field := embedded.component.StructFields[fieldKey]
+
+				fieldBinding, _ := fieldBindingTag(field, bindingType)
+				if fieldBinding.NotShown {
+					continue
+				}
+
+				embeddedIn := embedded.embeddedIn
+				if depth == 0 {
+					embeddedIn = fieldKey
+				}
+
+				if embeddedComponent, promoted := findEmbeddedStruct(service, field, bindingType); promoted {
+					embeddedKey := embeddedComponent.Package + "." + embeddedComponent.Name
+					if i, ok := nextIndex[embeddedKey]; ok {
+						next[i].count++
+						continue
+					}
+
+					nextIndex[embeddedKey] = len(next)
+					next = append(next, embeddedStruct{
+						component:  embeddedComponent,
+						embeddedIn: embeddedIn,
+						count:      1,
+					})
+
+					if depth == 0 {
+						resolved.embedded = append(resolved.embedded, fieldKey)
+					}
+					continue
+				}
+
+				candidate := promotedField{
+					field:      field,
+					binding:    fieldBinding,
+					depth:      depth,
+					embeddedIn: embedded.embeddedIn,
+				}
+
+				// A struct embedded more than once at the same depth has each of its fields conflict with itself
+				for i := 0; i < min(embedded.count, 2); i++ {
+					candidates[fieldBinding.Name] = append(candidates[fieldBinding.Name], candidate)
+				}
+			}
+		}
+
+		current = next
+	}
+
+	for name, fields := range candidates {
+		if field, ok := dominantField(fields); ok {
+			resolved.fields[name] = field
+		}
+	}
+
+	return resolved, true
+}
+
+// dominantField finds the field that is shown out of the fields with the same name, in order of depth.
+// The shallowest field is shown, unless there are more than one at that depth, in which case the only one with the name in its tag is shown.
+// Otherwise, the fields conflict and none of them are shown.
+func dominantField(fields []promotedField) (promotedField, bool) {
+	shallowest := make([]promotedField, 0, len(fields))
+	for _, field := range fields {
+		if field.depth != fields[0].depth {
+			break
+		}
+
+		shallowest = append(shallowest, field)
+	}
+
+	if len(shallowest) == 1 {
+		return shallowest[0], true
+	}
+
+	named := make([]promotedField, 0, len(shallowest))
+	for _, field := range shallowest {
+		if field.binding.IsNamed {
+			named = append(named, field)
+		}
+	}
+
+	if len(named) == 1 {
+		return named[0], true
+	}
+
+	return promotedField{}, false
+}
@@ -0,0 +1,223 @@
+package openapi
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/assert"
+)
+
+// jsonField creates a struct field with a json tag, where an empty name is the name of the field.
+func jsonField(name, fieldType, tagName string) astra.Field {
+	tag := astTraversal.BindingTag{Name: name}
+	if tagName != "" {
+		tag = astTraversal.BindingTag{Name: tagName, IsNamed: true}
+	}
+
+	return astra.Field{
+		Name: name,
+		Type: fieldType,
+		StructFieldBindingTags: astTraversal.BindingTagMap{
+			astTraversal.JSONBindingTag: tag,
+		},
+	}
+}
+
+// embeddedField creates an embedded struct field without a tag.
+func embeddedField(structType string) astra.Field {
+	return astra.Field{
+		Type:       structType,
+		Package:    "pets",
+		IsEmbedded: true,
+		StructFieldBindingTags: astTraversal.BindingTagMap{
+			astTraversal.NoBindingTag: {Name: structType},
+		},
+	}
+}
+
+// structComponent creates a struct component in the pets package.
+func structComponent(name string, fields map[string]astra.Field) astra.Field {
+	return astra.Field{
+		Name:         name,
+		Package:      "pets",
+		Type:         "struct",
+		StructFields: fields,
+	}
+}
+
+func TestResolveStructFields(t *testing.T) {
+	base := structComponent("Base", map[string]astra.Field{
+		"ID":   jsonField("ID", "int", "id"),
+		"Name": jsonField("Name", "string", ""),
+	})
+	audit := structComponent("Audit", map[string]astra.Field{
+		"CreatedBy": jsonField("CreatedBy", "string", "Name"),
+		"Created":   jsonField("Created", "string", "created"),
+	})
+	owner := structComponent("Owner", map[string]astra.Field{
+		"Name": jsonField("Name", "string", ""),
+	})
+
+	service := astra.New()
+	service.Components = []astra.Field{base, audit, owner}
+
+	resolve := func(component astra.Field) resolvedStruct {
+		resolved, bound := resolveStructFields(service, component, astTraversal.JSONBindingTag)
+		assert.True(t, bound)
+
+		return resolved
+	}
+
+	names := func(resolved resolvedStruct) []string {
+		fieldNames := make([]string, 0, len(resolved.fields))
+		for name := range resolved.fields {
+			fieldNames = append(fieldNames, name)
+		}
+		slices.Sort(fieldNames)
+
+		return fieldNames
+	}
+
+	t.Run("promotes the fields of embedded structs", func(t *testing.T) {
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base":  embeddedField("Base"),
+			"Breed": jsonField("Breed", "string", "breed"),
+		}))
+
+		assert.Equal(t, []string{"Name", "breed", "id"}, names(resolved))
+		assert.Equal(t, []string{"Base"}, resolved.embedded)
+		assert.Equal(t, "Base", resolved.fields["id"].embeddedIn)
+		assert.Equal(t, "", resolved.fields["breed"].embeddedIn)
+	})
+
+	t.Run("shows the shallower field", func(t *testing.T) {
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base": embeddedField("Base"),
+			"Name": jsonField("Name", "int", ""),
+		}))
+
+		assert.Equal(t, []string{"Name", "id"}, names(resolved))
+		assert.Equal(t, "", resolved.fields["Name"].embeddedIn)
+		assert.Equal(t, "int", resolved.fields["Name"].field.Type)
+	})
+
+	t.Run("shows the field with the name in its tag at the same depth", func(t *testing.T) {
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base":  embeddedField("Base"),
+			"Audit": embeddedField("Audit"),
+		}))
+
+		assert.Equal(t, []string{"Name", "created", "id"}, names(resolved))
+		assert.Equal(t, "Audit", resolved.fields["Name"].embeddedIn)
+		assert.Equal(t, "CreatedBy", resolved.fields["Name"].field.Name)
+	})
+
+	t.Run("hides the fields that conflict at the same depth", func(t *testing.T) {
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base":  embeddedField("Base"),
+			"Owner": embeddedField("Owner"),
+		}))
+
+		assert.Equal(t, []string{"id"}, names(resolved))
+	})
+
+	t.Run("hides the fields of a struct embedded twice at the same depth", func(t *testing.T) {
+		service.Components = append(service.Components,
+			structComponent("Cat", map[string]astra.Field{"Owner": embeddedField("Owner")}),
+			structComponent("Dog", map[string]astra.Field{"Owner": embeddedField("Owner")}),
+		)
+
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Cat": embeddedField("Cat"),
+			"Dog": embeddedField("Dog"),
+		}))
+
+		assert.Empty(t, names(resolved))
+		assert.Equal(t, []string{"Cat", "Dog"}, resolved.embedded)
+	})
+
+	t.Run("doesn't promote an embedded struct with a name in its tag", func(t *testing.T) {
+		namedBase := embeddedField("Base")
+		namedBase.StructFieldBindingTags = astTraversal.BindingTagMap{
+			astTraversal.JSONBindingTag: {Name: "base", IsNamed: true},
+		}
+
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base": namedBase,
+		}))
+
+		assert.Equal(t, []string{"base"}, names(resolved))
+		assert.Empty(t, resolved.embedded)
+	})
+
+	t.Run("doesn't show an embedded struct that isn't shown", func(t *testing.T) {
+		hiddenBase := embeddedField("Base")
+		hiddenBase.StructFieldBindingTags = astTraversal.BindingTagMap{
+			astTraversal.JSONBindingTag: {NotShown: true},
+		}
+
+		resolved := resolve(structComponent("Pet", map[string]astra.Field{
+			"Base": hiddenBase,
+		}))
+
+		assert.Empty(t, names(resolved))
+	})
+
+	t.Run("isn't bound if its own fields aren't", func(t *testing.T) {
+		_, bound := resolveStructFields(service, structComponent("Pet", map[string]astra.Field{
+			"Base": embeddedField("Base"),
+		}), astTraversal.FormBindingTag)
+
+		assert.True(t, bound)
+
+		_, bound = resolveStructFields(service, base, astTraversal.FormBindingTag)
+		assert.False(t, bound)
+	})
+}
+
+func TestComponentToSchema_Embedded(t *testing.T) {
+	collisionSafeNames = make(map[string]string)
+	collisionSafeNames[collisionSafeKey(astTraversal.NoBindingTag, "Base", "pets")] = "pets.Base"
+
+	service := astra.New()
+	service.Components = []astra.Field{
+		structComponent("Base", map[string]astra.Field{
+			"ID":   jsonField("ID", "int", "id"),
+			"Name": jsonField("Name", "string", "name"),
+		}),
+	}
+
+	t.Run("references an embedded struct", func(t *testing.T) {
+		schema, bound := componentToSchema(service, structComponent("Pet", map[string]astra.Field{
+			"Base":  embeddedField("Base"),
+			"Breed": jsonField("Breed", "string", "breed"),
+		}), astTraversal.JSONBindingTag)
+
+		assert.True(t, bound)
+		assert.Equal(t, Schema{
+			Type: "object",
+			AllOf: []Schema{
+				{Ref: "#/components/schemas/pets.Base"},
+				{Properties: map[string]Schema{"breed": {Type: "string"}}},
+			},
+		}, schema)
+	})
+
+	t.Run("adds the fields of an embedded struct that are promoted when some are hidden", func(t *testing.T) {
+		schema, bound := componentToSchema(service, structComponent("Pet", map[string]astra.Field{
+			"Base": embeddedField("Base"),
+			"Name": jsonField("Name", "int", "name"),
+		}), astTraversal.JSONBindingTag)
+
+		assert.True(t, bound)
+		assert.Equal(t, Schema{
+			Type: "object",
+			Properties: map[string]Schema{
+				"id":   {Type: "integer", Format: "int32"},
+				"name": {Type: "integer", Format: "int32"},
+			},
+		}, schema)
+	})
+}
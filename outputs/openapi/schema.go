@@ -1,6 +1,10 @@
 package openapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/astTraversal"
 )
@@ -36,6 +40,16 @@ func mapParamToSchema(bindingType astTraversal.BindingTagType, param astra.Param
 			Type:                 "object",
 			AdditionalProperties: &additionalProperties,
 		}, true
+	} else if !astra.IsAcceptedType(param.Field.Type) {
+		// A param of a named type (i.e. an enum) refers to its component
+		componentRef, bound := makeComponentRef(bindingType, param.Field.Type, param.Field.Package)
+		if bound {
+			return Schema{
+				Ref: componentRef,
+			}, true
+		}
+
+		return mapPredefinedTypeFormat(param.Field.Type), true
 	} else {
 		return mapPredefinedTypeFormat(param.Field.Type), true
 	}
@@ -90,10 +104,19 @@ func mapTypeFormat(service *astra.Service, acceptedType string, pkg string) Sche
 		if acceptedType.Type == "" {
 			return Schema{}
 		}
-		return Schema{
-			Type:   acceptedType.Type,
-			Format: acceptedType.Format,
+
+		schema := Schema{
+			Type:     acceptedType.Type,
+			Format:   acceptedType.Format,
+			Nullable: acceptedType.Nullable,
 		}
+
+		// A type with more than one format is any of them, while keeping its type
+		for _, format := range acceptedType.Formats {
+			schema.AnyOf = append(schema.AnyOf, Schema{Format: format})
+		}
+
+		return schema
 	}
 
 	return Schema{}
@@ -136,3 +159,126 @@ func findComponentByPackageAndType(fields []astra.Field, pkg string, typeName st
 
 	return astra.Field{}, false
 }
+
+// nullableSchema marks the schema as nullable (i.e. for a pointer).
+// If the schema is a reference, nothing can be added alongside it, so the reference is wrapped in an allOf.
+func nullableSchema(schema Schema) Schema {
+	if schema.Ref != "" {
+		return Schema{
+			AllOf:    []Schema{schema},
+			Nullable: true,
+		}
+	}
+
+	schema.Nullable = true
+	return schema
+}
+
+// oneOfSchemas combines two schemas into a schema that is one of them (i.e. for a stream with events of different types).
+// The schemas of an existing oneOf are added to, and a schema that is already one of them isn't added again.
+func oneOfSchemas(existing Schema, schema Schema) Schema {
+	if reflect.DeepEqual(existing, schema) {
+		return existing
+	}
+
+	if len(existing.OneOf) == 0 {
+		existing = Schema{
+			OneOf: []Schema{existing},
+		}
+	}
+
+	for _, oneOf := range existing.OneOf {
+		if reflect.DeepEqual(oneOf, schema) {
+			return existing
+		}
+	}
+
+	existing.OneOf = append(existing.OneOf, schema)
+	return existing
+}
+
+// stringEncodedSchema converts the schema of a number or boolean to a string, keeping the format of the number (i.e. for the ,string option of a JSON tag).
+// The constraints on the value of the number can't be applied to a string, so they are removed, and the values of an enum become strings.
+// Other schemas are already strings or can't be encoded as one, so they are left as they are.
+func stringEncodedSchema(schema Schema) Schema {
+	if schema.Type != "integer" && schema.Type != "number" && schema.Type != "boolean" {
+		return schema
+	}
+
+	schema.Type = "string"
+	schema.Minimum = nil
+	schema.Maximum = nil
+	schema.ExclusiveMinimum = false
+	schema.ExclusiveMaximum = false
+	schema.MultipleOf = 0
+
+	if schema.Enum != nil {
+		enum := make([]any, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			enum = append(enum, fmt.Sprint(value))
+		}
+		schema.Enum = enum
+	}
+
+	return schema
+}
+
+// applyDocMetadata adds the metadata from the doc comment of a field or component to its schema (deprecated, the examples and the default).
+// If the schema is a reference, nothing can be added alongside it, so the reference is wrapped in an allOf.
+func applyDocMetadata(schema Schema, field astra.Field) Schema {
+	if !field.Deprecated && len(field.Examples) == 0 && field.Default == "" {
+		return schema
+	}
+
+	if schema.Ref != "" {
+		schema = Schema{
+			AllOf: []Schema{schema},
+		}
+	}
+
+	schema.Deprecated = field.Deprecated
+
+	if field.Default != "" {
+		schema.Default = docValue(schema, field.Default)
+	}
+
+	// A schema in OpenAPI 3.0 only has a single example, so the first example is used
+	if len(field.Examples) > 0 {
+		schema.Example = docValue(schema, field.Examples[0])
+	}
+
+	return schema
+}
+
+// docValue converts a value from a doc comment to the type of the schema, so 1 is a number for an integer but a string for a string.
+// Values are parsed as JSON (i.e. "quoted strings", objects and arrays), and are left as strings if they aren't valid JSON or don't match the type of the schema.
+func docValue(schema Schema, value string) any {
+	var jsonValue any
+	err := json.Unmarshal([]byte(value), &jsonValue)
+	if err != nil {
+		return value
+	}
+
+	switch jsonValue.(type) {
+	case string:
+		return jsonValue
+	case float64:
+		if schema.Type == "integer" || schema.Type == "number" || schema.Type == "" {
+			return jsonValue
+		}
+	case bool:
+		if schema.Type == "boolean" || schema.Type == "" {
+			return jsonValue
+		}
+	case map[string]any:
+		if schema.Type == "object" || schema.Type == "" {
+			return jsonValue
+		}
+	case []any:
+		if schema.Type == "array" || schema.Type == "" {
+			return jsonValue
+		}
+	}
+
+	return value
+}
@@ -1,6 +1,8 @@
 package openapi
 
 import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -45,3 +47,154 @@ func TestGetQueryParamStyle(t *testing.T) {
 		})
 	})
 }
+
+func TestMapParamToSchema(t *testing.T) {
+	t.Run("it maps a param of a primitive type", func(t *testing.T) {
+		schema, bound := mapParamToSchema(astTraversal.URIBindingTag, astra.Param{Name: "id", Field: astra.Field{Type: "int64"}})
+
+		require.True(t, bound)
+		require.Equal(t, Schema{Type: "integer", Format: "int64"}, schema)
+	})
+
+	t.Run("it refers to the component of a param of a named type", func(t *testing.T) {
+		collisionSafeNames = make(map[string]string)
+		collisionSafeNames[collisionSafeKey(astTraversal.NoBindingTag, "Species", "pets")] = "pets.Species"
+
+		schema, bound := mapParamToSchema(astTraversal.URIBindingTag, astra.Param{Name: "species", Field: astra.Field{Type: "Species", Package: "pets"}})
+
+		require.True(t, bound)
+		require.Equal(t, Schema{Ref: "#/components/schemas/pets.Species"}, schema)
+	})
+}
+
+func TestMapTypeFormat(t *testing.T) {
+	t.Run("it maps the type of a preset", func(t *testing.T) {
+		service := astra.New(astra.WithTypePreset(astra.PresetSQL))
+
+		require.Equal(t, Schema{Type: "string", Format: "date-time", Nullable: true}, mapTypeFormat(service, "NullTime", "database/sql"))
+	})
+
+	t.Run("it maps a type with more than one format to any of them", func(t *testing.T) {
+		service := astra.New(astra.WithTypePreset(astra.PresetNetwork))
+
+		require.Equal(t, Schema{Type: "string", AnyOf: []Schema{{Format: "ipv4"}, {Format: "ipv6"}}}, mapTypeFormat(service, "Addr", "net/netip"))
+	})
+
+	t.Run("it maps an unknown type to an empty schema", func(t *testing.T) {
+		service := astra.New()
+
+		require.Equal(t, Schema{}, mapTypeFormat(service, "NullTime", "database/sql"))
+	})
+}
+
+func TestNullableSchema(t *testing.T) {
+	t.Run("it marks the schema as nullable", func(t *testing.T) {
+		require.Equal(t, Schema{Type: "string", Nullable: true}, nullableSchema(Schema{Type: "string"}))
+	})
+
+	t.Run("it wraps a reference in an allOf", func(t *testing.T) {
+		ref := Schema{Ref: "#/components/schemas/pkg.Pet"}
+
+		require.Equal(t, Schema{AllOf: []Schema{ref}, Nullable: true}, nullableSchema(ref))
+	})
+}
+
+func TestOneOfSchemas(t *testing.T) {
+	created := Schema{Ref: "#/components/schemas/pkg.Created"}
+	deleted := Schema{Ref: "#/components/schemas/pkg.Deleted"}
+	updated := Schema{Ref: "#/components/schemas/pkg.Updated"}
+
+	t.Run("it combines two schemas", func(t *testing.T) {
+		require.Equal(t, Schema{OneOf: []Schema{created, deleted}}, oneOfSchemas(created, deleted))
+	})
+
+	t.Run("it adds to an existing oneOf", func(t *testing.T) {
+		require.Equal(t, Schema{OneOf: []Schema{created, deleted, updated}}, oneOfSchemas(Schema{OneOf: []Schema{created, deleted}}, updated))
+	})
+
+	t.Run("it doesn't add a schema twice", func(t *testing.T) {
+		require.Equal(t, created, oneOfSchemas(created, created))
+		require.Equal(t, Schema{OneOf: []Schema{created, deleted}}, oneOfSchemas(Schema{OneOf: []Schema{created, deleted}}, deleted))
+	})
+}
+
+func TestStringEncodedSchema(t *testing.T) {
+	float := func(value float64) *float64 {
+		return &value
+	}
+
+	t.Run("it converts numbers to strings with the same format", func(t *testing.T) {
+		schema := stringEncodedSchema(Schema{Type: "integer", Format: "int64", Minimum: float(1)})
+
+		require.Equal(t, Schema{Type: "string", Format: "int64"}, schema)
+	})
+
+	t.Run("it converts the values of an enum to strings", func(t *testing.T) {
+		schema := stringEncodedSchema(Schema{Type: "integer", Format: "int32", Enum: []any{int64(1), int64(2)}})
+
+		require.Equal(t, []any{"1", "2"}, schema.Enum)
+	})
+
+	t.Run("it converts booleans to strings", func(t *testing.T) {
+		require.Equal(t, Schema{Type: "string"}, stringEncodedSchema(Schema{Type: "boolean"}))
+	})
+
+	t.Run("it leaves other schemas", func(t *testing.T) {
+		ref := Schema{Ref: "#/components/schemas/pkg.Pet"}
+
+		require.Equal(t, ref, stringEncodedSchema(ref))
+		require.Equal(t, Schema{Type: "array", Items: &Schema{Type: "integer"}}, stringEncodedSchema(Schema{Type: "array", Items: &Schema{Type: "integer"}}))
+	})
+}
+
+func TestApplyDocMetadata(t *testing.T) {
+	t.Run("it leaves the schema without metadata", func(t *testing.T) {
+		require.Equal(t, Schema{Type: "string"}, applyDocMetadata(Schema{Type: "string"}, astra.Field{}))
+	})
+
+	t.Run("it adds the metadata with the type of the schema", func(t *testing.T) {
+		schema := applyDocMetadata(Schema{Type: "integer"}, astra.Field{
+			Deprecated: true,
+			Examples:   []string{"3"},
+			Default:    "1",
+		})
+
+		require.Equal(t, Schema{
+			Type:       "integer",
+			Deprecated: true,
+			Example:    3.0,
+			Default:    1.0,
+		}, schema)
+	})
+
+	t.Run("it uses the first example for more than one example", func(t *testing.T) {
+		schema := applyDocMetadata(Schema{Type: "string"}, astra.Field{
+			Examples: []string{`"dog"`, "cat"},
+		})
+
+		require.Equal(t, Schema{Type: "string", Example: "dog"}, schema)
+	})
+
+	t.Run("it wraps a reference in an allOf", func(t *testing.T) {
+		ref := Schema{Ref: "#/components/schemas/pkg.Owner"}
+		schema := applyDocMetadata(ref, astra.Field{
+			Examples: []string{`{"name": "Sam"}`},
+		})
+
+		require.Equal(t, Schema{
+			AllOf:   []Schema{ref},
+			Example: map[string]any{"name": "Sam"},
+		}, schema)
+	})
+}
+
+func TestDocValue(t *testing.T) {
+	require.Equal(t, 1.5, docValue(Schema{Type: "number"}, "1.5"))
+	require.Equal(t, true, docValue(Schema{Type: "boolean"}, "true"))
+	require.Equal(t, []any{"a"}, docValue(Schema{Type: "array"}, `["a"]`))
+
+	// Values that don't match the type of the schema are strings
+	require.Equal(t, "123", docValue(Schema{Type: "string"}, "123"))
+	require.Equal(t, "yes", docValue(Schema{Type: "boolean"}, "yes"))
+	require.Equal(t, "hello world", docValue(Schema{Type: "string"}, "hello world"))
+}
@@ -168,9 +168,9 @@ type Schema struct {
 	Ref                  string            `json:"$ref,omitempty" yaml:"$ref,omitempty"`
 	Title                string            `json:"title,omitempty" yaml:"title,omitempty"`
 	MultipleOf           float64           `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
-	Maximum              float64           `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	Maximum              *float64          `json:"maximum,omitempty" yaml:"maximum,omitempty"`
 	ExclusiveMaximum     bool              `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
-	Minimum              float64           `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Minimum              *float64          `json:"minimum,omitempty" yaml:"minimum,omitempty"`
 	ExclusiveMinimum     bool              `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
 	MaxLength            int               `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
 	MinLength            int               `json:"minLength,omitempty" yaml:"minLength,omitempty"`
@@ -182,10 +182,13 @@ type Schema struct {
 	MinProperties        int               `json:"minProperties,omitempty" yaml:"minProperties,omitempty"`
 	Required             []string          `json:"required,omitempty" yaml:"required,omitempty"`
 	Enum                 []interface{}     `json:"enum,omitempty" yaml:"enum,omitempty"`
+	XEnumVarNames        []string          `json:"x-enum-varnames,omitempty" yaml:"x-enum-varnames,omitempty"`
 	Type                 string            `json:"type,omitempty" yaml:"type,omitempty"`
 	Format               string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Nullable             bool              `json:"nullable,omitempty" yaml:"nullable,omitempty"`
 	AllOf                []Schema          `json:"allOf,omitempty" yaml:"allOf,omitempty"`
 	OneOf                []Schema          `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
+	Discriminator        *Discriminator    `json:"discriminator,omitempty" yaml:"discriminator,omitempty"`
 	AnyOf                []Schema          `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
 	Not                  *Schema           `json:"not,omitempty" yaml:"not,omitempty"`
 	Items                *Schema           `json:"items,omitempty" yaml:"items,omitempty"`
@@ -193,6 +196,15 @@ type Schema struct {
 	PatternProperties    map[string]Schema `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
 	AdditionalProperties *Schema           `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
 	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Default              any               `json:"default,omitempty" yaml:"default,omitempty"`
+	Example              any               `json:"example,omitempty" yaml:"example,omitempty"`
+	Deprecated           bool              `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+}
+
+// Discriminator is the OpenAPI discriminator, which tells the schemas of a oneOf apart by the value of a property.
+type Discriminator struct {
+	PropertyName string            `json:"propertyName" yaml:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty" yaml:"mapping,omitempty"`
 }
 
 // SecurityScheme is the OpenAPI security scheme.
@@ -0,0 +1,265 @@
+package openapi
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// validationFormats maps the validation rules that check the format of a string to their OpenAPI format.
+var validationFormats = map[string]string{
+	"email":            "email",
+	"url":              "uri",
+	"http_url":         "uri",
+	"uri":              "uri",
+	"uuid":             "uuid",
+	"uuid3":            "uuid",
+	"uuid4":            "uuid",
+	"uuid5":            "uuid",
+	"uuid_rfc4122":     "uuid",
+	"ipv4":             "ipv4",
+	"ipv6":             "ipv6",
+	"hostname":         "hostname",
+	"hostname_rfc1123": "hostname",
+	"fqdn":             "hostname",
+	"base64":           "byte",
+}
+
+// validationPatterns maps the validation rules that check the characters of a string to a pattern.
+var validationPatterns = map[string]string{
+	"alpha":       `^[a-zA-Z]+$`,
+	"alphanum":    `^[a-zA-Z0-9]+$`,
+	"numeric":     `^[-+]?[0-9]+(?:\.[0-9]+)?$`,
+	"number":      `^[0-9]+$`,
+	"hexadecimal": `^(0[xX])?[0-9a-fA-F]+$`,
+	"e164":        `^\+[1-9]?[0-9]{7,14}$`,
+}
+
+// oneOfValuesRegex matches the values of a oneof rule, which are separated by spaces and can be quoted to include spaces.
+var oneOfValuesRegex = regexp.MustCompile(`'[^']*'|\S+`)
+
+// applyValidationTags adds the constraints from the validation tags of a struct field (from both the binding and validate tags) to its schema.
+// If the schema is a reference, the constraints can't be added alongside it, so the reference is wrapped in an allOf.
+func applyValidationTags(service *astra.Service, schema Schema, field astra.Field) Schema {
+	validationTag := astTraversal.ValidationTag{}
+	for _, validationTagType := range astTraversal.ValidationTags {
+		fieldValidationTag, ok := field.StructFieldValidationTags[validationTagType]
+		if !ok {
+			continue
+		}
+
+		validationTag.Rules = append(validationTag.Rules, fieldValidationTag.Rules...)
+		if fieldValidationTag.Dive != nil {
+			if validationTag.Dive == nil {
+				validationTag.Dive = &astTraversal.ValidationTag{}
+			}
+
+			validationTag.Dive.Rules = append(validationTag.Dive.Rules, fieldValidationTag.Dive.Rules...)
+			validationTag.Dive.Dive = fieldValidationTag.Dive.Dive
+		}
+	}
+
+	return applyValidationTag(service, schema, field, validationTag)
+}
+
+// applyValidationTag adds the constraints from the validation tag to the schema of the field.
+// The rules after a dive are applied to the schema of the items of a slice, array or map.
+func applyValidationTag(service *astra.Service, schema Schema, field astra.Field, validationTag astTraversal.ValidationTag) Schema {
+	if len(validationTag.Rules) == 0 && validationTag.Dive == nil {
+		return schema
+	}
+
+	kind := validationKind(service, schema, field)
+
+	if schema.Ref != "" {
+		constraints := Schema{}
+		applyValidationRules(&constraints, kind, validationTag.Rules)
+		if reflect.DeepEqual(constraints, Schema{}) {
+			return schema
+		}
+
+		constraints.AllOf = []Schema{schema}
+		return constraints
+	}
+
+	applyValidationRules(&schema, kind, validationTag.Rules)
+
+	if validationTag.Dive != nil {
+		if schema.Items != nil {
+			itemType := field.SliceType
+			if field.Type == "array" {
+				itemType = field.ArrayType
+			}
+
+			items := applyValidationTag(service, *schema.Items, astra.Field{Type: itemType, Package: field.Package}, *validationTag.Dive)
+			schema.Items = &items
+		} else if schema.AdditionalProperties != nil {
+			additionalProperties := applyValidationTag(service, *schema.AdditionalProperties, astra.Field{Type: field.MapValueType, Package: field.Package}, *validationTag.Dive)
+			schema.AdditionalProperties = &additionalProperties
+		}
+	}
+
+	return schema
+}
+
+// validationKind returns the OpenAPI type the validation rules are applied to.
+// The same rule constrains the value of a number, but the length of a string, array or object (i.e. min=1).
+// For a reference, the type of the referenced component is used.
+func validationKind(service *astra.Service, schema Schema, field astra.Field) string {
+	if schema.Ref == "" {
+		return schema.Type
+	}
+
+	if _, ok := service.GetTypeMapping(field.Type, field.Package); ok {
+		return mapTypeFormat(service, field.Type, field.Package).Type
+	}
+
+	component, found := findComponentByPackageAndType(service.Components, field.Package, field.Type)
+	if !found {
+		return ""
+	}
+
+	switch component.Type {
+	case "slice", "array":
+		return "array"
+	case "map", "struct":
+		return "object"
+	default:
+		return mapPredefinedTypeFormat(component.Type).Type
+	}
+}
+
+// applyValidationRules adds the constraints for each of the rules to the schema, based on the kind of value it is applied to.
+// Rules that don't have an equivalent in OpenAPI (or don't apply to the kind) are ignored.
+func applyValidationRules(schema *Schema, kind string, rules []astTraversal.ValidationRule) {
+	for _, rule := range rules {
+		switch rule.Name {
+		case "min", "gte":
+			setMinimum(schema, kind, rule.Param, false)
+		case "gt":
+			setMinimum(schema, kind, rule.Param, true)
+		case "max", "lte":
+			setMaximum(schema, kind, rule.Param, false)
+		case "lt":
+			setMaximum(schema, kind, rule.Param, true)
+		case "len":
+			setMinimum(schema, kind, rule.Param, false)
+			setMaximum(schema, kind, rule.Param, false)
+		case "eq":
+			if kind == "string" {
+				schema.Enum = []any{rule.Param}
+			} else {
+				setMinimum(schema, kind, rule.Param, false)
+				setMaximum(schema, kind, rule.Param, false)
+			}
+		case "oneof":
+			schema.Enum = oneOfValues(kind, rule.Param)
+		case "unique":
+			if kind == "array" {
+				schema.UniqueItems = true
+			}
+		case "startswith":
+			if kind == "string" {
+				schema.Pattern = "^" + regexp.QuoteMeta(rule.Param)
+			}
+		case "endswith":
+			if kind == "string" {
+				schema.Pattern = regexp.QuoteMeta(rule.Param) + "$"
+			}
+		case "contains":
+			if kind == "string" {
+				schema.Pattern = regexp.QuoteMeta(rule.Param)
+			}
+		default:
+			if kind != "string" {
+				continue
+			}
+
+			if format, ok := validationFormats[rule.Name]; ok {
+				schema.Format = format
+			} else if pattern, ok := validationPatterns[rule.Name]; ok {
+				schema.Pattern = pattern
+			}
+		}
+	}
+}
+
+// setMinimum sets the minimum of a number, or the minimum length of a string, array or object.
+func setMinimum(schema *Schema, kind string, param string, exclusive bool) {
+	value, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	length := int(value)
+	if exclusive {
+		length++
+	}
+
+	switch kind {
+	case "integer", "number":
+		schema.Minimum = &value
+		schema.ExclusiveMinimum = exclusive
+	case "string":
+		schema.MinLength = length
+	case "array":
+		schema.MinItems = length
+	case "object":
+		schema.MinProperties = length
+	}
+}
+
+// setMaximum sets the maximum of a number, or the maximum length of a string, array or object.
+func setMaximum(schema *Schema, kind string, param string, exclusive bool) {
+	value, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	length := int(value)
+	if exclusive {
+		length--
+	}
+
+	switch kind {
+	case "integer", "number":
+		schema.Maximum = &value
+		schema.ExclusiveMaximum = exclusive
+	case "string":
+		schema.MaxLength = length
+	case "array":
+		schema.MaxItems = length
+	case "object":
+		schema.MaxProperties = length
+	}
+}
+
+// oneOfValues splits the values of a oneof rule, converting them to numbers if they are applied to a number.
+func oneOfValues(kind string, param string) []any {
+	values := make([]any, 0)
+	for _, value := range oneOfValuesRegex.FindAllString(param, -1) {
+		if len(value) > 1 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+
+		switch kind {
+		case "integer":
+			if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+				values = append(values, intValue)
+				continue
+			}
+		case "number":
+			if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+				values = append(values, floatValue)
+				continue
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
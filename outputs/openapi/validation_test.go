@@ -0,0 +1,162 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyValidationTags(t *testing.T) {
+	float := func(value float64) *float64 {
+		return &value
+	}
+
+	service := &astra.Service{
+		Components: []astra.Field{
+			{Name: "Tags", Package: "pkg", Type: "slice", SliceType: "string"},
+		},
+	}
+
+	t.Run("it constrains the value of numbers", func(t *testing.T) {
+		schema := applyValidationTags(service, Schema{Type: "integer"}, astra.Field{
+			Type: "int",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "gte", Param: "0"},
+						{Name: "lt", Param: "100"},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, Schema{
+			Type:             "integer",
+			Minimum:          float(0),
+			Maximum:          float(100),
+			ExclusiveMaximum: true,
+		}, schema)
+	})
+
+	t.Run("it constrains the length and format of strings", func(t *testing.T) {
+		schema := applyValidationTags(service, Schema{Type: "string"}, astra.Field{
+			Type: "string",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "min", Param: "3"},
+						{Name: "alphanum"},
+					},
+				},
+				astTraversal.ValidatorValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "max", Param: "20"},
+						{Name: "email"},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, Schema{
+			Type:      "string",
+			Format:    "email",
+			Pattern:   `^[a-zA-Z0-9]+$`,
+			MinLength: 3,
+			MaxLength: 20,
+		}, schema)
+	})
+
+	t.Run("it converts oneof to an enum", func(t *testing.T) {
+		schema := applyValidationTags(service, Schema{Type: "string"}, astra.Field{
+			Type: "string",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "oneof", Param: "red 'dark green'"},
+					},
+				},
+			},
+		})
+		require.Equal(t, []any{"red", "dark green"}, schema.Enum)
+
+		schema = applyValidationTags(service, Schema{Type: "integer"}, astra.Field{
+			Type: "int",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "oneof", Param: "1 2"},
+					},
+				},
+			},
+		})
+		require.Equal(t, []any{int64(1), int64(2)}, schema.Enum)
+	})
+
+	t.Run("it applies the rules after a dive to the items", func(t *testing.T) {
+		schema := applyValidationTags(service, Schema{Type: "array", Items: &Schema{Type: "string"}}, astra.Field{
+			Type:      "slice",
+			SliceType: "string",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.ValidatorValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "min", Param: "1"},
+					},
+					Dive: &astTraversal.ValidationTag{
+						Rules: []astTraversal.ValidationRule{
+							{Name: "uuid4"},
+						},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, Schema{
+			Type:     "array",
+			MinItems: 1,
+			Items: &Schema{
+				Type:   "string",
+				Format: "uuid",
+			},
+		}, schema)
+	})
+
+	t.Run("it wraps a reference in an allOf", func(t *testing.T) {
+		ref := Schema{Ref: "#/components/schemas/pkg.Tags"}
+		schema := applyValidationTags(service, ref, astra.Field{
+			Type:    "Tags",
+			Package: "pkg",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					Rules: []astTraversal.ValidationRule{
+						{Name: "max", Param: "5"},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, Schema{
+			AllOf:    []Schema{ref},
+			MaxItems: 5,
+		}, schema)
+	})
+
+	t.Run("it leaves the schema if no rules apply", func(t *testing.T) {
+		ref := Schema{Ref: "#/components/schemas/pkg.Tags"}
+		schema := applyValidationTags(service, ref, astra.Field{
+			Type:    "Tags",
+			Package: "pkg",
+			StructFieldValidationTags: astTraversal.ValidationTagMap{
+				astTraversal.GinValidationTag: {
+					IsRequired: true,
+					Rules: []astTraversal.ValidationRule{
+						{Name: "email"},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, ref, schema)
+	})
+}
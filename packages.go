@@ -0,0 +1,37 @@
+package astra
+
+import "github.com/ls6-events/astra/astTraversal"
+
+// PackageManager returns the package manager shared by all the route traversals, creating it if it doesn't exist yet.
+// Sharing the package manager means each package is only loaded and populated once, no matter how many routes use it.
+func (s *Service) PackageManager() *astTraversal.PackageManager {
+	defer s.lock()()
+
+	if s.packageManager == nil {
+		s.packageManager = astTraversal.NewPackageManager(s.WorkDir)
+
+		s.packageManager.AddPathLoader(func(path string) (string, error) {
+			if path == "main" {
+				return s.GetMainPackageName()
+			}
+			return path, nil
+		})
+	}
+
+	return s.packageManager
+}
+
+// LoadPackages loads the packages from their paths in a single call to the shared package manager.
+// The dependencies the packages share are only type checked once, rather than once for every package.
+func (s *Service) LoadPackages(pkgPaths ...string) error {
+	s.Log.Debug().Strs("packages", pkgPaths).Msg("Loading packages")
+
+	err := s.PackageManager().Load(pkgPaths...)
+	if err != nil {
+		return err
+	}
+
+	s.Log.Debug().Msg("Loaded packages")
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package astra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PackageManager(t *testing.T) {
+	service := New()
+
+	packageManager := service.PackageManager()
+	require.NotNil(t, packageManager)
+
+	// The same package manager is shared every time
+	require.Same(t, packageManager, service.PackageManager())
+}
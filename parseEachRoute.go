@@ -0,0 +1,142 @@
+package astra
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// RouteParser is a function that parses a single route, populating it in place.
+type RouteParser func(route *Route) error
+
+// ParseEachRoute calls the parser for each of the routes in the service, using up to Concurrency workers at the same time.
+// Once all the routes have been parsed, the routes are replaced and their components are added in the order of the routes,
+// so the output is the same regardless of how the routes were scheduled.
+// If any route fails to parse, the routes after it are skipped and the error for the first of the failed routes is returned, so the error is also the same regardless of the scheduling.
+// If parsing is lenient, every route is parsed and the failures are recorded as diagnostics (in the order of the routes) instead, keeping the failed routes as they were left.
+// Routes that can be reused from the route cache aren't parsed again, and if the route cache is enabled every route is recorded in it for the next build.
+func (s *Service) ParseEachRoute(parse RouteParser) error {
+	workers := s.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	routes := make([]Route, len(s.Routes))
+	copy(routes, s.Routes)
+
+	if s.mu == nil {
+		s.mu = &sync.Mutex{}
+	}
+
+	s.routeComponents = make(map[*Route][]Field, len(routes))
+	s.routePackageManagers = make(map[*Route]*astTraversal.PackageManager)
+	cachedRoutes := make(map[int]CachedRoute)
+	for i := range routes {
+		if cachedRoute, ok := s.findCachedRoute(routes[i]); ok {
+			s.Log.Debug().Str("path", routes[i].Path).Str("method", routes[i].Method).Msg("Reusing cached route")
+
+			cachedRoutes[i] = cachedRoute
+			routes[i] = cachedRoute.Route
+			s.routeComponents[&routes[i]] = cachedRoute.Components
+			continue
+		}
+
+		s.routeComponents[&routes[i]] = make([]Field, 0)
+		if s.routeCacheEnabled() {
+			s.routePackageManagers[&routes[i]] = s.PackageManager().Track()
+		}
+	}
+
+	if len(cachedRoutes) > 0 {
+		s.Log.Info().Int("reused", len(cachedRoutes)).Int("total", len(routes)).Msg("Reusing routes from the route cache")
+	}
+
+	errs := make([]error, len(routes))
+
+	// firstFailed is the lowest index of the routes that have failed, routes after it are skipped but routes before it are still parsed
+	var firstFailed atomic.Int64
+	firstFailed.Store(int64(len(routes)))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if _, ok := cachedRoutes[i]; ok || int64(i) > firstFailed.Load() {
+					continue
+				}
+
+				errs[i] = parse(&routes[i])
+				if errs[i] != nil && !s.LenientParsing {
+					// Keep the lowest index, retrying if another worker has changed it in the meantime
+					for {
+						failed := firstFailed.Load()
+						if int64(i) >= failed || firstFailed.CompareAndSwap(failed, int64(i)) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+
+	for i := range routes {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	routeComponents := s.routeComponents
+	routePackageManagers := s.routePackageManagers
+	s.routeComponents = nil
+	s.routePackageManagers = nil
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if !s.LenientParsing {
+			return err
+		}
+
+		s.addDiagnostic(routes[i], err)
+	}
+
+	routeCache := make([]CachedRoute, 0, len(routes))
+	optionsHash := s.parseOptionsHash()
+	for i := range routes {
+		// Failed routes aren't cached, so they are parsed again in the next build
+		if s.routeCacheEnabled() && errs[i] == nil {
+			cachedRoute, ok := cachedRoutes[i]
+			if !ok {
+				dependencies, err := s.routeDependencies(routePackageManagers[&routes[i]])
+				if err != nil {
+					s.Log.Warn().Str("path", routes[i].Path).Str("method", routes[i].Method).Err(err).Msg("Failed to find the dependencies of the route, it won't be cached")
+				} else {
+					cachedRoute.Dependencies = dependencies
+					ok = true
+				}
+			}
+
+			if ok {
+				cachedRoute.Route = routes[i]
+				cachedRoute.OptionsHash = optionsHash
+				cachedRoute.Components = routeComponents[&routes[i]]
+				routeCache = append(routeCache, copyThroughJSON(cachedRoute))
+			}
+		}
+
+		s.AddComponent(nil, routeComponents[&routes[i]]...)
+		s.ReplaceRoute(routes[i])
+	}
+
+	if s.routeCacheEnabled() {
+		s.RouteCache = routeCache
+	}
+
+	return nil
+}
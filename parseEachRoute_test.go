@@ -0,0 +1,97 @@
+package astra
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ParseEachRoute(t *testing.T) {
+	newService := func(concurrency int) *Service {
+		service := New(WithConcurrency(concurrency))
+		for i := 0; i < 20; i++ {
+			service.AddRoute(Route{
+				Path:   fmt.Sprintf("/test/%d", i),
+				Method: "GET",
+			})
+		}
+		return service
+	}
+
+	parse := func(service *Service) RouteParser {
+		return func(route *Route) error {
+			route.Doc = route.Path
+
+			service.AddComponent(route, Field{Name: "Shared", Package: "test"}, Field{Name: route.Path, Package: "test"})
+
+			return nil
+		}
+	}
+
+	t.Run("parses each route", func(t *testing.T) {
+		service := newService(1)
+
+		err := service.ParseEachRoute(parse(service))
+		require.NoError(t, err)
+
+		require.Len(t, service.Routes, 20)
+		for _, route := range service.Routes {
+			require.Equal(t, route.Path, route.Doc)
+		}
+		require.Len(t, service.Components, 21)
+	})
+
+	t.Run("is deterministic when concurrent", func(t *testing.T) {
+		sequential := newService(1)
+		err := sequential.ParseEachRoute(parse(sequential))
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			concurrent := newService(8)
+			err := concurrent.ParseEachRoute(parse(concurrent))
+			require.NoError(t, err)
+
+			require.Equal(t, sequential.Routes, concurrent.Routes)
+			require.Equal(t, sequential.Components, concurrent.Components)
+		}
+	})
+
+	t.Run("returns the error of the first failed route", func(t *testing.T) {
+		service := newService(4)
+
+		err := service.ParseEachRoute(func(route *Route) error {
+			if route.Path == "/test/3" || route.Path == "/test/7" {
+				return errors.New(route.Path)
+			}
+			return nil
+		})
+		require.EqualError(t, err, "/test/3")
+	})
+
+	t.Run("records the failed routes as diagnostics when lenient", func(t *testing.T) {
+		service := newService(4)
+		WithLenientParsing()(service)
+
+		cause := errors.New("handler not found")
+		err := service.ParseEachRoute(func(route *Route) error {
+			route.Doc = "Partial"
+			if route.Path == "/test/3" || route.Path == "/test/7" {
+				return cause
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Len(t, service.Diagnostics, 2)
+		require.Equal(t, "/test/3", service.Diagnostics[0].Path)
+		require.Equal(t, "GET", service.Diagnostics[0].Method)
+		require.ErrorIs(t, service.Diagnostics[0], cause)
+		require.Equal(t, "/test/7", service.Diagnostics[1].Path)
+
+		// The failed routes are kept with what was found before they failed
+		require.Len(t, service.Routes, 20)
+		require.Equal(t, "Partial", service.Routes[3].Doc)
+	})
+}
@@ -4,6 +4,7 @@ package astra
 // CreateRoutes should be called before ParseRoutes.
 func (s *Service) ParseRoutes() error {
 	s.Log.Info().Msg("Parsing routes from inputs")
+	s.Diagnostics = nil
 	for _, input := range s.Inputs {
 		s.Log.Info().Str("mode", string(input.Mode)).Msg("Parsing routes from input")
 		err := input.ParseRoutes(s)
@@ -15,6 +16,10 @@ func (s *Service) ParseRoutes() error {
 	}
 	s.Log.Info().Msg("Parsing routes from inputs complete")
 
+	if len(s.Diagnostics) > 0 {
+		s.Log.Warn().Int("failed", len(s.Diagnostics)).Int("total", len(s.Routes)).Msg("Some routes failed to parse, see the diagnostics for the causes")
+	}
+
 	if s.CacheEnabled {
 		err := s.Cache()
 		if err != nil {
@@ -0,0 +1,341 @@
+package astra
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// The route cache records every route that has been parsed, along with the hashes of the source files in every package its traversal used.
+// On the next build, a route is reused instead of parsed again if it is created the same way, none of those files have changed and it is parsed with the same options.
+// It is stored in the cache file, so it is only used if the cache is enabled or the CLI is used.
+
+// routeCacheVersion is the version of how the routes are parsed and stored in the route cache.
+// It is part of the hash of the parsing options, so it must be increased whenever a change to the parsing would change the routes of an existing route cache.
+const routeCacheVersion = 1
+
+// astraModulePath is the path of the astra module, whose version is also part of the hash of the parsing options.
+const astraModulePath = "github.com/ls6-events/astra"
+
+// CachedRoute is a parsed route from a previous build.
+// It holds everything needed to reuse the route if none of its dependencies or parsing options have changed.
+type CachedRoute struct {
+	Route        Route        `json:"route" yaml:"route"`
+	Components   []Field      `json:"components,omitempty" yaml:"components,omitempty"`
+	Dependencies []Dependency `json:"dependencies" yaml:"dependencies"`
+	OptionsHash  string       `json:"optionsHash" yaml:"optionsHash"`
+}
+
+// Dependency is a package that was used when parsing a route.
+// It holds the hashes of every source file in the package (including the files ignored due to build constraints), by their file path.
+type Dependency struct {
+	Package string            `json:"package" yaml:"package"`
+	Files   map[string]string `json:"files" yaml:"files"`
+}
+
+// routeCacheEnabled returns whether the routes should be recorded in the route cache.
+// This is only worth doing if the cache will be written to be used by the next build.
+func (s *Service) routeCacheEnabled() bool {
+	return s.CacheEnabled || s.CLIMode == CLIModeBuilder
+}
+
+// loadRouteCache loads the route cache from the existing cache file, before it is overwritten by this build.
+// If the cache file doesn't exist or can't be read, the routes will all be parsed.
+func (s *Service) loadRouteCache() {
+	if s.ForceRebuild || len(s.RouteCache) > 0 {
+		return
+	}
+
+	cachePath := s.CachePath
+	if cachePath == "" {
+		cachePath = path.Join(s.getAstraDirPath(), cacheFileName)
+	}
+
+	if _, err := os.Stat(cachePath); err != nil {
+		return
+	}
+
+	service, err := readCache(cachePath)
+	if err != nil {
+		s.Log.Warn().Err(err).Msg("Failed to read the route cache, all routes will be parsed")
+		return
+	}
+
+	s.RouteCache = service.RouteCache
+	s.Log.Debug().Int("routes", len(s.RouteCache)).Msg("Loaded route cache")
+}
+
+// CacheRoutes writes the route cache into an existing cache file, leaving the rest of the cache as it is.
+// This is used by the CLI, where the cache file is created by the program rather than the build.
+func (s *Service) CacheRoutes(cachePath string) error {
+	s.Log.Debug().Msg("Caching routes")
+
+	service, err := readCache(cachePath)
+	if err != nil {
+		return err
+	}
+
+	service.RouteCache = s.RouteCache
+
+	err = writeCache(cachePath, service)
+	if err != nil {
+		return err
+	}
+
+	s.Log.Debug().Msg("Cached routes")
+	return nil
+}
+
+// RoutesToParse returns the routes that can't be reused from the route cache, so need to be parsed.
+func (s *Service) RoutesToParse() []Route {
+	routes := make([]Route, 0, len(s.Routes))
+	for _, route := range s.Routes {
+		if _, ok := s.findCachedRoute(route); !ok {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes
+}
+
+// RoutePackageManager returns the package manager to use when traversing the route.
+// While the routes are parsed with ParseEachRoute, it records the packages used by the route, so they can be stored in the route cache.
+// Otherwise, it is the package manager shared by all the route traversals.
+func (s *Service) RoutePackageManager(route *Route) *astTraversal.PackageManager {
+	packageManager := s.PackageManager()
+
+	defer s.lock()()
+
+	if routePackageManager, ok := s.routePackageManagers[route]; ok {
+		return routePackageManager
+	}
+
+	return packageManager
+}
+
+// findCachedRoute finds the route in the route cache, if it is created the same way and neither its dependencies nor the parsing options have changed.
+// The cached route is copied, so it can be changed without affecting the route cache.
+func (s *Service) findCachedRoute(route Route) (CachedRoute, bool) {
+	if s.ForceRebuild {
+		return CachedRoute{}, false
+	}
+
+	for _, cachedRoute := range s.RouteCache {
+		if cachedRoute.Route.Method != route.Method || cachedRoute.Route.Path != route.Path || cachedRoute.Route.Handler != route.Handler || cachedRoute.Route.File != route.File || cachedRoute.Route.LineNo != route.LineNo {
+			continue
+		}
+
+		if cachedRoute.OptionsHash != s.parseOptionsHash() {
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Parsing options have changed")
+			return CachedRoute{}, false
+		}
+
+		if !s.dependenciesUnchanged(cachedRoute.Dependencies) {
+			return CachedRoute{}, false
+		}
+
+		return copyThroughJSON(cachedRoute), true
+	}
+
+	return CachedRoute{}, false
+}
+
+// dependenciesUnchanged checks whether every file in the dependencies still has the same hash, and no files have been added to their packages.
+func (s *Service) dependenciesUnchanged(dependencies []Dependency) bool {
+	for _, dependency := range dependencies {
+		dirs := make(map[string]struct{})
+		for file, hash := range dependency.Files {
+			currentHash, err := s.hashFile(file)
+			if err != nil || currentHash != hash {
+				s.Log.Debug().Str("package", dependency.Package).Str("file", file).Msg("Dependency has changed")
+				return false
+			}
+
+			dirs[filepath.Dir(file)] = struct{}{}
+		}
+
+		// A new file in the package could change the types, even if none of the existing files have changed
+		for dir := range dirs {
+			entries, err := os.ReadDir(s.cacheFilePath(dir))
+			if err != nil {
+				return false
+			}
+
+			for _, entry := range entries {
+				if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+					continue
+				}
+
+				if _, ok := dependency.Files[filepath.Join(dir, entry.Name())]; !ok {
+					s.Log.Debug().Str("package", dependency.Package).Str("file", entry.Name()).Msg("Dependency has a new file")
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// parseOptionsHash returns the SHA-256 hash of the options that change how the routes are parsed.
+// These are set in the code rather than the source files of the route, so a change to them wouldn't be seen in the dependencies.
+// The version of the route cache and of astra are included, so upgrading astra parses every route again.
+// The custom functions can't be compared, so only their number is included (a change within a custom function needs a forced rebuild).
+// The maps are marshalled with sorted keys, so the hash is the same for the same options.
+func (s *Service) parseOptionsHash() string {
+	data, err := json.Marshal(struct {
+		Version               int                     `json:"version"`
+		AstraVersion          string                  `json:"astraVersion"`
+		CustomFuncs           int                     `json:"customFuncs"`
+		CustomTypeMapping     map[string]TypeFormat   `json:"customTypeMapping"`
+		TypePresets           []TypePreset            `json:"typePresets"`
+		Interfaces            astTraversal.Interfaces `json:"interfaces"`
+		SubstituteTypes       map[string]Field        `json:"substituteTypes"`
+		JSONMarshalerFallback TypeFormat              `json:"jsonMarshalerFallback"`
+	}{
+		Version:               routeCacheVersion,
+		AstraVersion:          astraVersion(),
+		CustomFuncs:           len(s.CustomFuncs),
+		CustomTypeMapping:     s.CustomTypeMapping,
+		TypePresets:           s.TypePresets,
+		Interfaces:            s.Interfaces,
+		SubstituteTypes:       s.SubstituteTypes,
+		JSONMarshalerFallback: s.JSONMarshalerFallback,
+	})
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// astraVersion returns the version of the astra module that the program is built with.
+// It is empty if the version isn't known (i.e. astra is the main module, or it is replaced with a local copy).
+func astraVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	if info.Main.Path == astraModulePath {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == astraModulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+
+			return dep.Version
+		}
+	}
+
+	return ""
+}
+
+// routeDependencies returns the packages used through the package manager, with the hashes of their source files.
+func (s *Service) routeDependencies(packageManager *astTraversal.PackageManager) ([]Dependency, error) {
+	dependencies := make([]Dependency, 0)
+	for _, packageNode := range packageManager.Used() {
+		if packageNode.Package == nil {
+			continue
+		}
+
+		dependency := Dependency{
+			Package: packageNode.Package.PkgPath,
+			Files:   make(map[string]string),
+		}
+
+		for _, file := range slices.Concat(packageNode.Package.GoFiles, packageNode.Package.IgnoredFiles) {
+			file = s.sourceFilePath(file)
+
+			hash, err := s.hashFile(file)
+			if err != nil {
+				return nil, err
+			}
+
+			dependency.Files[file] = hash
+		}
+
+		dependencies = append(dependencies, dependency)
+	}
+
+	return dependencies, nil
+}
+
+// sourceFilePath returns the path of the file as it is stored in the route cache.
+// Files in the working directory are relative to it, so the cache can be used from anywhere the project is.
+// Files in the temporary main package are replaced by the original files in the main package, as the temporary copies are recreated for every build.
+func (s *Service) sourceFilePath(file string) string {
+	if filepath.Dir(file) == filepath.Join(s.getAstraDirPath(), mainPackageReplacement) {
+		file = filepath.Join(s.WorkDir, filepath.Base(file))
+	}
+
+	if relativePath, err := filepath.Rel(s.WorkDir, file); err == nil && !strings.HasPrefix(relativePath, "..") {
+		return relativePath
+	}
+
+	return file
+}
+
+// cacheFilePath returns the path of a file stored in the route cache, so it can be read.
+func (s *Service) cacheFilePath(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(s.WorkDir, file)
+}
+
+// hashFile returns the SHA-256 hash of the file stored in the route cache.
+// The hashes are kept for the rest of the build, so each file is only read once.
+func (s *Service) hashFile(file string) (string, error) {
+	defer s.lock()()
+
+	if hash, ok := s.fileHashes[file]; ok {
+		return hash, nil
+	}
+
+	contents, err := os.ReadFile(s.cacheFilePath(file))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(contents)
+	hash := hex.EncodeToString(sum[:])
+
+	if s.fileHashes == nil {
+		s.fileHashes = make(map[string]string)
+	}
+	s.fileHashes[file] = hash
+
+	return hash, nil
+}
+
+// copyThroughJSON makes a deep copy of the value by marshalling it to JSON and back.
+// The routes and components are changed in place after they are parsed (i.e. when cleaning), which would otherwise change the route cache too.
+func copyThroughJSON[T any](value T) T {
+	var result T
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return value
+	}
+
+	return result
+}
@@ -0,0 +1,178 @@
+package astra
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_FindCachedRoute(t *testing.T) {
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "handlers.go"), []byte("package main"), 0644))
+
+	newService := func(opts ...Option) *Service {
+		s := New(append([]Option{WithCustomWorkDir(workDir), WithSubstituteType("main.ID", Field{Type: "string"})}, opts...)...)
+
+		hash, err := s.hashFile("handlers.go")
+		require.NoError(t, err)
+
+		s.RouteCache = []CachedRoute{
+			{
+				Route: Route{
+					Handler: "main.handler",
+					File:    "handlers.go",
+					LineNo:  10,
+					Method:  "GET",
+					Path:    "/test",
+					Doc:     "Cached",
+				},
+				Components: []Field{
+					{Name: "TestType", Package: "main"},
+				},
+				Dependencies: []Dependency{
+					{
+						Package: "main",
+						Files: map[string]string{
+							"handlers.go": hash,
+						},
+					},
+				},
+				OptionsHash: New(WithSubstituteType("main.ID", Field{Type: "string"})).parseOptionsHash(),
+			},
+		}
+
+		// The hashes are kept for the whole build, so a new service is needed to see any changes
+		s.fileHashes = nil
+
+		return s
+	}
+
+	route := Route{
+		Handler: "main.handler",
+		File:    "handlers.go",
+		LineNo:  10,
+		Method:  "GET",
+		Path:    "/test",
+	}
+
+	t.Run("finds an unchanged route", func(t *testing.T) {
+		s := newService()
+
+		cachedRoute, ok := s.findCachedRoute(route)
+		require.True(t, ok)
+		require.Equal(t, "Cached", cachedRoute.Route.Doc)
+		require.Equal(t, []Field{{Name: "TestType", Package: "main"}}, cachedRoute.Components)
+
+		// The cached route is a copy
+		cachedRoute.Components[0].Name = "Changed"
+		require.Equal(t, "TestType", s.RouteCache[0].Components[0].Name)
+	})
+
+	t.Run("doesn't find a route that is created differently", func(t *testing.T) {
+		s := newService()
+
+		movedRoute := route
+		movedRoute.LineNo = 11
+
+		_, ok := s.findCachedRoute(movedRoute)
+		require.False(t, ok)
+	})
+
+	t.Run("doesn't find a route when forcing a rebuild", func(t *testing.T) {
+		s := newService()
+		s.ForceRebuild = true
+
+		_, ok := s.findCachedRoute(route)
+		require.False(t, ok)
+	})
+
+	t.Run("doesn't find a route when a file has changed", func(t *testing.T) {
+		s := newService()
+
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "handlers.go"), []byte("package main\n\nfunc handler() {}"), 0644))
+		defer func() {
+			require.NoError(t, os.WriteFile(filepath.Join(workDir, "handlers.go"), []byte("package main"), 0644))
+		}()
+
+		_, ok := s.findCachedRoute(route)
+		require.False(t, ok)
+	})
+
+	t.Run("doesn't find a route when a file has been added to a dependency", func(t *testing.T) {
+		s := newService()
+
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "types.go"), []byte("package main"), 0644))
+		defer func() {
+			require.NoError(t, os.Remove(filepath.Join(workDir, "types.go")))
+		}()
+
+		_, ok := s.findCachedRoute(route)
+		require.False(t, ok)
+	})
+
+	t.Run("doesn't find a route when the parsing options have changed", func(t *testing.T) {
+		_, ok := newService(WithTypePreset(PresetUUID)).findCachedRoute(route)
+		require.False(t, ok)
+
+		_, ok = newService(WithSubstituteType("main.ID", Field{Type: "integer"})).findCachedRoute(route)
+		require.False(t, ok)
+
+		_, ok = newService(WithCustomFunc(func(string, *ContextFuncBuilder) (*Route, error) { return nil, nil })).findCachedRoute(route)
+		require.False(t, ok)
+	})
+
+	t.Run("ignores test files added to a dependency", func(t *testing.T) {
+		s := newService()
+
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "handlers_test.go"), []byte("package main"), 0644))
+		defer func() {
+			require.NoError(t, os.Remove(filepath.Join(workDir, "handlers_test.go")))
+		}()
+
+		_, ok := s.findCachedRoute(route)
+		require.True(t, ok)
+	})
+}
+
+func TestService_SourceFilePath(t *testing.T) {
+	s := &Service{
+		WorkDir: "/project",
+	}
+
+	require.Equal(t, "handlers.go", s.sourceFilePath("/project/handlers.go"))
+	require.Equal(t, "nested/types.go", s.sourceFilePath("/project/nested/types.go"))
+	require.Equal(t, "/other/types.go", s.sourceFilePath("/other/types.go"))
+
+	// The temporary main package is replaced by the original main package
+	require.Equal(t, "main.go", s.sourceFilePath("/project/.astra/astramain/main.go"))
+}
+
+func TestService_CacheRoutes(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "cache.json")
+
+	existing := &Service{
+		Routes: []Route{
+			{Method: "GET", Path: "/test"},
+		},
+	}
+	require.NoError(t, writeCache(cachePath, existing))
+
+	s := &Service{
+		Routes: []Route{
+			{Method: "GET", Path: "/test", Doc: "Parsed"},
+		},
+		RouteCache: []CachedRoute{
+			{Route: Route{Method: "GET", Path: "/test", Doc: "Parsed"}},
+		},
+	}
+	require.NoError(t, s.CacheRoutes(cachePath))
+
+	cached, err := readCache(cachePath)
+	require.NoError(t, err)
+
+	// Only the route cache is changed
+	require.Equal(t, existing.Routes, cached.Routes)
+	require.Equal(t, s.RouteCache, cached.RouteCache)
+}
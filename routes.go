@@ -6,7 +6,10 @@ func (s *Service) AddRoute(route Route) {
 }
 
 // ReplaceRoute replaces a route in the service using the path and method as indexes.
+// It is safe to call concurrently.
 func (s *Service) ReplaceRoute(route Route) {
+	defer s.lock()()
+
 	for i, r := range s.Routes {
 		if r.Path == route.Path && r.Method == route.Method {
 			s.Routes[i] = route
@@ -41,3 +41,37 @@ func AddComponent(prev []Field, n ...Field) []Field {
 
 	return prev
 }
+
+// AddParam adds a param to a slice of params if it doesn't already exist.
+// It uses the name to determine if the param already exists, as a param is only documented once for each route.
+func AddParam(prev []Param, n ...Param) []Param {
+	for _, newParam := range n {
+		var found bool
+		for _, existingParam := range prev {
+			if newParam.Name == existingParam.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			prev = append(prev, newParam)
+		}
+	}
+
+	return prev
+}
+
+// AddComponent adds components found while parsing a route to the service.
+// It is safe to call concurrently.
+// While the routes are parsed with ParseEachRoute, the components are held per route and merged in the order of the routes once they have all been parsed,
+// so the order of the components doesn't depend on how the routes were scheduled.
+func (s *Service) AddComponent(route *Route, n ...Field) {
+	defer s.lock()()
+
+	if components, ok := s.routeComponents[route]; ok {
+		s.routeComponents[route] = AddComponent(components, n...)
+		return
+	}
+
+	s.Components = AddComponent(s.Components, n...)
+}
@@ -104,3 +104,38 @@ func TestAddComponent(t *testing.T) {
 		assert.Equal(t, expected, result)
 	})
 }
+
+func TestAddParam(t *testing.T) {
+	t.Run("AddingToEmptySlice", func(t *testing.T) {
+		var emptySlice []Param
+		newParam := Param{Name: "callback"}
+		result := AddParam(emptySlice, newParam)
+		expected := []Param{newParam}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("AddingToExistingSliceWithSameParam", func(t *testing.T) {
+		existingSlice := []Param{{Name: "callback", Field: Field{Type: "string"}}}
+		newParam := Param{Name: "callback", Field: Field{Type: "string"}}
+		result := AddParam(existingSlice, newParam)
+		assert.Equal(t, existingSlice, result)
+	})
+
+	t.Run("AddingToExistingSliceWithDifferentParam", func(t *testing.T) {
+		existingSlice := []Param{{Name: "callback"}}
+		differentParam := Param{Name: "status"}
+		result := AddParam(existingSlice, differentParam)
+		expected := append(existingSlice, differentParam)
+		assert.Equal(t, expected, result)
+	})
+}
+
+func TestService_AddComponent(t *testing.T) {
+	service := &Service{}
+	route := &Route{}
+
+	service.AddComponent(route, Field{Name: "TestType", Package: "test"})
+	service.AddComponent(route, Field{Name: "TestType", Package: "test"})
+
+	assert.Equal(t, []Field{{Name: "TestType", Package: "test"}}, service.Components)
+}
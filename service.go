@@ -1,6 +1,10 @@
 package astra
 
 import (
+	"sync"
+
+	"github.com/ls6-events/astra/astTraversal"
+
 	"github.com/rs/zerolog"
 )
 
@@ -36,12 +40,56 @@ type Service struct {
 	CachePath    string  `json:"-"`
 	CLIMode      CLIMode `json:"-"`
 
+	// RouteCache holds the parsed routes from the previous build, which are reused if none of the files they depend on have changed
+	RouteCache []CachedRoute `json:"routeCache,omitempty" yaml:"routeCache,omitempty"`
+	// ForceRebuild ignores the route cache, so every route is parsed again
+	ForceRebuild bool `json:"-" yaml:"-"`
+	// fileHashes holds the hashes of the files read in this build, so each file is only hashed once
+	fileHashes map[string]string
+
 	PathDenyList []func(string) bool `json:"-" yaml:"-"`
 
 	CustomFuncs []CustomFunc `json:"-" yaml:"-"`
 
 	// CustomTypeMapping is a map of custom types to their OpenAPI type and format
 	CustomTypeMapping map[string]TypeFormat `json:"custom_type_mapping" yaml:"custom_type_mapping"`
+	// TypePresets are the curated sets of type mappings that are used in addition to the predefined type mapping
+	TypePresets []TypePreset `json:"type_presets,omitempty" yaml:"type_presets,omitempty"`
+	// Interfaces configures how the implementations of named interfaces are found
+	Interfaces astTraversal.Interfaces `json:"interfaces" yaml:"interfaces"`
+	// SubstituteTypes is a map of types to the fields that replace them, in addition to the predefined substitute types
+	SubstituteTypes map[string]Field `json:"substitute_types" yaml:"substitute_types"`
+	// JSONMarshalerFallback is the OpenAPI type and format of the types that implement json.Marshaler without a custom type mapping (defaults to any type)
+	JSONMarshalerFallback TypeFormat `json:"json_marshaler_fallback" yaml:"json_marshaler_fallback"`
 	// fullTypeMapping is a full map of types to their OpenAPI type and format (to save merging the custom type mapping with the predefined type mapping every time)
 	fullTypeMapping map[string]TypeFormat
+
+	// LenientParsing records the routes that fail to parse as diagnostics and carries on, rather than stopping at the first failure
+	LenientParsing bool `json:"-" yaml:"-"`
+	// Diagnostics holds the failures to parse the routes when parsing is lenient
+	Diagnostics []Diagnostic `json:"-" yaml:"-"`
+
+	// Concurrency is the number of routes that are parsed at the same time (defaults to 1)
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+
+	// mu guards the state that is shared between the routes while they are parsed concurrently
+	// It is a pointer so the service can still be copied (i.e. when caching)
+	mu *sync.Mutex
+	// packageManager is the package manager shared by all the route traversals
+	packageManager *astTraversal.PackageManager
+	// routePackageManagers holds the package manager for each route while the routes are parsed, which records the packages used by the route
+	routePackageManagers map[*Route]*astTraversal.PackageManager
+	// routeComponents holds the components found for each route while the routes are parsed, so they can be merged in the order of the routes
+	routeComponents map[*Route][]Field
+}
+
+// lock locks the state that is shared between the routes while they are parsed concurrently, returning the function to unlock it.
+func (s *Service) lock() func() {
+	if s.mu == nil {
+		// The mutex is always set before any routes are parsed concurrently, so there's nothing to guard against
+		return func() {}
+	}
+
+	s.mu.Lock()
+	return s.mu.Unlock
 }
@@ -30,6 +30,9 @@ func (s *Service) Setup() error {
 	}
 
 	if s.CacheEnabled {
+		// The routes from the previous build are loaded before the cache is overwritten, so they can be reused
+		s.loadRouteCache()
+
 		err := s.Cache()
 		if err != nil {
 			s.Log.Error().Err(err).Msg("Error caching")
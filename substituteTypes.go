@@ -3,25 +3,75 @@ package astra
 import "fmt"
 
 // substituteTypes is a map of types that need to be replaced with other types.
-var substituteTypes = map[string]string{
-	"time.Duration": "int",    // We can assume that all time.Duration definitions will be serialized as int.
-	"error":         "string", // We can assume that all errors will be serialized as strings.
+var substituteTypes = map[string]Field{
+	"time.Duration": {Type: "int"},    // We can assume that all time.Duration definitions will be serialized as int.
+	"error":         {Type: "string"}, // We can assume that all errors will be serialized as strings.
+}
+
+// WithSubstituteType is an option to replace a type with another field wherever it is used, which takes precedence over the predefined substitute types.
+// The type is referred to by its full name (i.e. database/sql.NullString or github.com/google/uuid.UUID).
+// The replacement can be any field, such as a primitive (Field{Type: "string"}), a slice (Field{Type: "slice", SliceType: "string"}) or a struct, whose struct fields need their binding tags to be shown.
+func WithSubstituteType(qualifiedName string, replacement Field) Option {
+	return func(s *Service) {
+		if s.SubstituteTypes == nil {
+			s.SubstituteTypes = make(map[string]Field)
+		}
+
+		s.SubstituteTypes[qualifiedName] = replacement
+	}
+}
+
+// getSubstituteType gets the replacement for a type, from the substitute types of the service or the predefined substitute types.
+func (s *Service) getSubstituteType(qualifiedName string) (Field, bool) {
+	if replacement, ok := s.SubstituteTypes[qualifiedName]; ok {
+		return replacement, true
+	}
+
+	replacement, ok := substituteTypes[qualifiedName]
+	return replacement, ok
 }
 
 // HandleSubstituteTypes handles substitute types.
+// The shape of the type is replaced, but the name, package and tags of the field are kept, so it is still referred to in the same way.
 func (s *Service) HandleSubstituteTypes(component *Field) {
 	qualifiedName := component.Name
 	if component.Package != "" {
 		qualifiedName = fmt.Sprintf("%s.%s", component.Package, component.Name)
 	}
 
-	if _, ok := substituteTypes[qualifiedName]; ok {
-		s.Log.Debug().Type("pkg", component.Package).Str("type", component.Name).Msg("Handling substitute types")
+	replacement, ok := s.getSubstituteType(qualifiedName)
+	if !ok {
+		return
+	}
+
+	s.Log.Debug().Str("pkg", component.Package).Str("type", component.Name).Msg("Handling substitute types")
+
+	component.Type = replacement.Type
+	component.EnumValues = replacement.EnumValues
+	component.EnumNames = replacement.EnumNames
 
-		component.Type = substituteTypes[qualifiedName]
+	component.SliceType = replacement.SliceType
+	component.ArrayType = replacement.ArrayType
+	component.ArrayLength = replacement.ArrayLength
+	component.MapKeyPackage = replacement.MapKeyPackage
+	component.MapKeyType = replacement.MapKeyType
+	component.MapValueType = replacement.MapValueType
+
+	component.TypeArgs = replacement.TypeArgs
+	component.Marshaler = replacement.Marshaler
+	component.Implementations = replacement.Implementations
+	component.Discriminator = replacement.Discriminator
+
+	component.StructFields = nil
+	if replacement.StructFields != nil {
+		// The struct fields are copied, so the replacement isn't changed when the fields are cleaned
+		component.StructFields = make(map[string]Field, len(replacement.StructFields))
+		for k, v := range replacement.StructFields {
+			component.StructFields[k] = v
+		}
+	}
 
-		component.StructFields = nil
-		component.SliceType = ""
-		component.MapValueType = ""
+	if replacement.Doc != "" {
+		component.Doc = replacement.Doc
 	}
 }
@@ -1,11 +1,34 @@
 package astra
 
 import (
+	"github.com/ls6-events/astra/astTraversal"
 	"github.com/stretchr/testify/require"
 	"strings"
 	"testing"
 )
 
+func TestWithSubstituteType(t *testing.T) {
+	t.Run("adds substitute type", func(t *testing.T) {
+		service := &Service{}
+
+		WithSubstituteType("database/sql.NullString", Field{Type: "string"})(service)
+
+		require.Equal(t, Field{Type: "string"}, service.SubstituteTypes["database/sql.NullString"])
+	})
+
+	t.Run("overwrites existing substitute type", func(t *testing.T) {
+		service := &Service{
+			SubstituteTypes: map[string]Field{
+				"database/sql.NullString": {Type: "int"},
+			},
+		}
+
+		WithSubstituteType("database/sql.NullString", Field{Type: "string"})(service)
+
+		require.Equal(t, Field{Type: "string"}, service.SubstituteTypes["database/sql.NullString"])
+	})
+}
+
 func TestService_HandleSubstituteTypes(t *testing.T) {
 	service := &Service{}
 
@@ -24,11 +47,11 @@ func TestService_HandleSubstituteTypes(t *testing.T) {
 				}
 			}
 
-			require.NotEqual(t, typeReplacement, typeValue.Type)
+			require.NotEqual(t, typeReplacement.Type, typeValue.Type)
 
 			service.HandleSubstituteTypes(typeValue)
 
-			require.Equal(t, typeReplacement, typeValue.Type)
+			require.Equal(t, typeReplacement.Type, typeValue.Type)
 		})
 	}
 
@@ -83,4 +106,80 @@ func TestService_HandleSubstituteTypes(t *testing.T) {
 
 		require.Equal(t, "", typeValue.MapValueType)
 	})
+
+	t.Run("prefers the substitute types of the service", func(t *testing.T) {
+		service := &Service{}
+		WithSubstituteType("time.Duration", Field{Type: "string"})(service)
+
+		typeValue := &Field{
+			Package: "time",
+			Name:    "Duration",
+			Type:    "int64",
+		}
+
+		service.HandleSubstituteTypes(typeValue)
+
+		require.Equal(t, "string", typeValue.Type)
+	})
+
+	t.Run("replaces the shape of the type but keeps the field", func(t *testing.T) {
+		service := &Service{}
+		WithSubstituteType("encoding/json.RawMessage", Field{
+			Type:      "slice",
+			SliceType: "int",
+			Doc:       "Raw JSON",
+		})(service)
+
+		typeValue := &Field{
+			Package:    "encoding/json",
+			Name:       "RawMessage",
+			Type:       "any",
+			IsRequired: true,
+			Marshaler:  astTraversal.JSONMarshaler,
+			StructFieldBindingTags: astTraversal.BindingTagMap{
+				astTraversal.JSONBindingTag: {Name: "raw"},
+			},
+		}
+
+		service.HandleSubstituteTypes(typeValue)
+
+		require.Equal(t, Field{
+			Package:    "encoding/json",
+			Name:       "RawMessage",
+			Type:       "slice",
+			SliceType:  "int",
+			IsRequired: true,
+			StructFieldBindingTags: astTraversal.BindingTagMap{
+				astTraversal.JSONBindingTag: {Name: "raw"},
+			},
+			Doc: "Raw JSON",
+		}, *typeValue)
+	})
+
+	t.Run("copies the struct fields of the replacement", func(t *testing.T) {
+		service := &Service{}
+		WithSubstituteType("database/sql.NullString", Field{
+			Type: "struct",
+			StructFields: map[string]Field{
+				"Value": {
+					Name: "Value",
+					Type: "string",
+				},
+			},
+		})(service)
+
+		typeValue := &Field{
+			Package: "database/sql",
+			Name:    "NullString",
+			Type:    "struct",
+		}
+
+		service.HandleSubstituteTypes(typeValue)
+
+		require.Equal(t, "struct", typeValue.Type)
+		require.Equal(t, service.SubstituteTypes["database/sql.NullString"].StructFields, typeValue.StructFields)
+
+		typeValue.StructFields["Value"] = Field{Name: "Value", Type: "int"}
+		require.Equal(t, "string", service.SubstituteTypes["database/sql.NullString"].StructFields["Value"].Type)
+	})
 }
@@ -31,11 +31,11 @@ func TestPaths(t *testing.T) {
 
 	// GET /pets/{id}
 	require.True(t, paths.Exists("/pets/{id}", "get"))
-	require.Equal(t, "string", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
+	require.Equal(t, "integer", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
 	require.Equal(t, "id", paths.Path("/pets/{id}.get.parameters.0.name").Data().(string))
 	require.Equal(t, "path", paths.Path("/pets/{id}.get.parameters.0.in").Data().(string))
 	require.True(t, paths.Path("/pets/{id}.get.parameters.0.required").Data().(bool))
-	require.Equal(t, "string", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
+	require.Equal(t, "integer", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
 	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/pets/{id}.get.responses.400.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/pets/{id}.get.responses.404.content.application/json.schema.$ref").Data().(string))
@@ -45,7 +45,7 @@ func TestPaths(t *testing.T) {
 	require.Equal(t, "id", paths.Path("/pets/{id}.get.parameters.0.name").Data().(string))
 	require.Equal(t, "path", paths.Path("/pets/{id}.get.parameters.0.in").Data().(string))
 	require.True(t, paths.Path("/pets/{id}.get.parameters.0.required").Data().(bool))
-	require.Equal(t, "string", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
+	require.Equal(t, "integer", paths.Path("/pets/{id}.get.parameters.0.schema.type").Data().(string))
 	require.True(t, paths.Exists("/pets/{id}", "delete", "responses", "200"))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/pets/{id}.delete.responses.400.content.application/json.schema.$ref").Data().(string))
 }
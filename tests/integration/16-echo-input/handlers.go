@@ -0,0 +1,79 @@
+package petstore
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/ls6-events/astra/tests/petstore"
+)
+
+func getAllPets(c echo.Context) error {
+	allPets := petstore.Pets
+
+	if c.QueryParam("status") != "" {
+		c.Response().Header().Set("X-Filtered", "true")
+	}
+
+	return c.JSON(http.StatusOK, allPets)
+}
+
+func getPetByID(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	pet, err := petstore.PetByID(int64(id))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, pet)
+}
+
+func createPet(c echo.Context) error {
+	var pet petstore.PetDTO
+	err := c.Bind(&pet)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	if c.Request().Header.Get("X-Request-ID") == "" {
+		return c.NoContent(http.StatusPreconditionFailed)
+	}
+
+	petstore.AddPet(petstore.Pet{
+		Name:      pet.Name,
+		PhotoURLs: pet.PhotoURLs,
+		Status:    pet.Status,
+		Tags:      pet.Tags,
+	})
+
+	return c.JSON(http.StatusOK, pet)
+}
+
+func deletePet(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	petstore.RemovePet(int64(id))
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// tagHandler is registered with its methods, rather than with functions.
+type tagHandler struct {
+	tags []petstore.Tag
+}
+
+func (h *tagHandler) listTags(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.tags)
+}
+
+func (h tagHandler) countTags(c echo.Context) error {
+	return c.JSON(http.StatusOK, len(h.tags))
+}
@@ -0,0 +1,65 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEchoInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	e := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithEchoInput(e), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	// GET /pets
+	require.True(t, paths.Exists("/pets", "get"))
+	require.Equal(t, "array", paths.Path("/pets.get.responses.200.content.application/json.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "status", paths.Path("/pets.get.parameters.0.name").Data().(string))
+	require.Equal(t, "query", paths.Path("/pets.get.parameters.0.in").Data().(string))
+	require.True(t, paths.Exists("/pets", "get", "responses", "200", "headers", "X-Filtered"))
+
+	// POST /pets
+	require.True(t, paths.Exists("/pets", "post"))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets.post.requestBody.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets.post.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.True(t, paths.Exists("/pets", "post", "responses", "400"))
+	require.True(t, paths.Exists("/pets", "post", "responses", "412"))
+	require.Equal(t, "X-Request-ID", paths.Path("/pets.post.parameters.0.name").Data().(string))
+	require.Equal(t, "header", paths.Path("/pets.post.parameters.0.in").Data().(string))
+
+	// GET /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "get"))
+	require.Equal(t, "id", paths.Path("/pets/{id}.get.parameters.0.name").Data().(string))
+	require.Equal(t, "path", paths.Path("/pets/{id}.get.parameters.0.in").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/v4.HTTPError", paths.Path("/pets/{id}.get.responses.400.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/v4.HTTPError", paths.Path("/pets/{id}.get.responses.404.content.application/json.schema.$ref").Data().(string))
+
+	// DELETE /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "delete", "responses", "204"))
+
+	// GET /tags and GET /tags/count (method handlers, with a pointer and a value receiver)
+	require.Equal(t, "array", paths.Path("/tags.get.responses.200.content.application/json.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Tag", paths.Path("/tags.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "integer", paths.Path("/tags/count.get.responses.200.content.application/json.schema.type").Data().(string))
+
+	// GET /tags/renamed (a renamed route is skipped, rather than failing the generation)
+	require.False(t, paths.Exists("/tags/renamed"))
+
+	// GET /health (inline handler)
+	require.Equal(t, "string", paths.Path("/health.get.responses.200.content.text/plain.schema.type").Data().(string))
+}
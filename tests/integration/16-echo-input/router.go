@@ -0,0 +1,29 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func setupRouter() *echo.Echo {
+	e := echo.New()
+
+	e.GET("/pets", getAllPets)
+	e.GET("/pets/:id", getPetByID)
+	e.POST("/pets", createPet)
+	e.DELETE("/pets/:id", deletePet)
+
+	tags := &tagHandler{}
+	e.GET("/tags", tags.listTags)
+	e.GET("/tags/count", tags.countTags)
+
+	// A renamed route can't be found from its name, so it is skipped
+	e.GET("/tags/renamed", tags.listTags).Name = "list-renamed-tags"
+
+	e.GET("/health", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	return e
+}
@@ -0,0 +1,128 @@
+package petstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ls6-events/astra/tests/petstore"
+)
+
+func getAllPets(w http.ResponseWriter, r *http.Request) {
+	allPets := petstore.Pets
+
+	if r.URL.Query().Get("status") != "" {
+		w.Header().Set("X-Filtered", "true")
+	}
+
+	json.NewEncoder(w).Encode(allPets)
+}
+
+func getPetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pet, err := petstore.PetByID(int64(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	json.NewEncoder(w).Encode(pet)
+}
+
+func createPet(w http.ResponseWriter, r *http.Request) {
+	var pet petstore.PetDTO
+	err := json.NewDecoder(r.Body).Decode(&pet)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("X-Request-ID") == "" {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+
+	petstore.AddPet(petstore.Pet{
+		Name:      pet.Name,
+		PhotoURLs: pet.PhotoURLs,
+		Status:    pet.Status,
+		Tags:      pet.Tags,
+	})
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(pet)
+}
+
+func updatePet(w http.ResponseWriter, r *http.Request) {
+	var pet petstore.PetDTO
+	err := json.NewDecoder(r.Body).Decode(&pet)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The status code is only set for the response written in this block
+	if pet.Status == "" {
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(pet)
+		return
+	}
+
+	json.NewEncoder(w).Encode(pet)
+}
+
+// exportPets encodes the pets into a buffer, so the response is written as plain text.
+func exportPets(w http.ResponseWriter, r *http.Request) {
+	var filter petstore.PetDTO
+	err := json.NewDecoder(strings.NewReader(r.URL.Query().Get("filter"))).Decode(&filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	err = encoder.Encode(petstore.Pets)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(buf.Bytes())
+}
+
+func deletePet(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	petstore.RemovePet(int64(id))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// petHandler is declared before tagHandler, so its method would be found first if the receiver was ignored.
+type petHandler struct{}
+
+func (h petHandler) list(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(petstore.Pets)
+}
+
+type tagHandler struct {
+	tags []petstore.Tag
+}
+
+func (h *tagHandler) list(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(h.tags)
+}
@@ -0,0 +1,79 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChiInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithChiInput(r), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	// GET /pets/
+	require.True(t, paths.Exists("/pets/", "get"))
+	require.Equal(t, "array", paths.Path("/pets/.get.responses.200.content.application/json.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "status", paths.Path("/pets/.get.parameters.0.name").Data().(string))
+	require.Equal(t, "query", paths.Path("/pets/.get.parameters.0.in").Data().(string))
+	require.True(t, paths.Exists("/pets/", "get", "responses", "200", "headers", "X-Filtered"))
+
+	// POST /pets/
+	require.True(t, paths.Exists("/pets/", "post"))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/.post.requestBody.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/.post.responses.201.content.application/json.schema.$ref").Data().(string))
+	require.False(t, paths.Exists("/pets/", "post", "responses", "200"))
+	require.Equal(t, "string", paths.Path("/pets/.post.responses.400.content.text/plain.schema.type").Data().(string))
+	require.True(t, paths.Exists("/pets/", "post", "responses", "412"))
+	require.False(t, paths.Exists("/pets/", "post", "responses", "412", "content"))
+	require.Equal(t, "X-Request-ID", paths.Path("/pets/.post.parameters.0.name").Data().(string))
+	require.Equal(t, "header", paths.Path("/pets/.post.parameters.0.in").Data().(string))
+
+	// GET /pets/{id}/ (nested router)
+	require.True(t, paths.Exists("/pets/{id}/", "get"))
+	require.Equal(t, "id", paths.Path("/pets/{id}/.get.parameters.0.name").Data().(string))
+	require.Equal(t, "path", paths.Path("/pets/{id}/.get.parameters.0.in").Data().(string))
+	require.Len(t, paths.Path("/pets/{id}/.get.parameters").Children(), 1)
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}/.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.True(t, paths.Exists("/pets/{id}/", "get", "responses", "400"))
+	require.True(t, paths.Exists("/pets/{id}/", "get", "responses", "404"))
+
+	// GET /pets/export (encoding and decoding that isn't on the response or request)
+	require.True(t, paths.Exists("/pets/export", "get"))
+	require.False(t, paths.Exists("/pets/export", "get", "requestBody"))
+	require.Equal(t, "string", paths.Path("/pets/export.get.responses.200.content.text/plain.schema.type").Data().(string))
+	require.False(t, paths.Exists("/pets/export", "get", "responses", "200", "content", "application/json"))
+
+	// PUT /pets/{id}/ (status code set within a block)
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/{id}/.put.responses.202.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/{id}/.put.responses.200.content.application/json.schema.$ref").Data().(string))
+
+	// DELETE /pets/{id}/ (nested router)
+	require.True(t, paths.Exists("/pets/{id}/", "delete", "responses", "204"))
+
+	// GET /admin/pets/{id} (mounted router with a regex param)
+	require.True(t, paths.Exists("/admin/pets/{id}", "get"))
+	require.Equal(t, "id", paths.Path("/admin/pets/{id}.get.parameters.0.name").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/admin/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+
+	// GET /tags and GET /pets/all (methods with the same name, with a pointer and a value receiver)
+	require.Equal(t, "#/components/schemas/petstore.Tag", paths.Path("/tags.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/all.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+
+	// GET /health (inline handler)
+	require.Equal(t, "string", paths.Path("/health.get.responses.200.content.text/plain.schema.type").Data().(string))
+}
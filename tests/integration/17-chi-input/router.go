@@ -0,0 +1,44 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func setupRouter() chi.Router {
+	r := chi.NewRouter()
+
+	r.Route("/pets", func(r chi.Router) {
+		r.Get("/", getAllPets)
+		r.Post("/", createPet)
+		r.Get("/export", exportPets)
+
+		r.Route("/{id}", func(r chi.Router) {
+			r.Get("/", getPetByID)
+			r.Put("/", updatePet)
+			r.Delete("/", deletePet)
+		})
+	})
+
+	// Both handlers are methods with the same name, on different types
+	tags := &tagHandler{}
+	r.Get("/tags", tags.list)
+	r.Get("/pets/all", petHandler{}.list)
+
+	r.Mount("/admin", adminRouter())
+
+	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	return r
+}
+
+func adminRouter() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/pets/{id:[0-9]+}", getPetByID)
+
+	return r
+}
@@ -0,0 +1,85 @@
+package petstore
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ls6-events/astra/tests/petstore"
+)
+
+func getAllPets(w http.ResponseWriter, r *http.Request) {
+	allPets := petstore.Pets
+
+	if r.URL.Query().Get("status") != "" {
+		w.Header().Set("X-Filtered", "true")
+	}
+
+	json.NewEncoder(w).Encode(allPets)
+}
+
+func getPetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pet, err := petstore.PetByID(int64(id))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	json.NewEncoder(w).Encode(pet)
+}
+
+func createPet(w http.ResponseWriter, r *http.Request) {
+	var pet petstore.PetDTO
+	err := json.NewDecoder(r.Body).Decode(&pet)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	petstore.AddPet(petstore.Pet{
+		Name:      pet.Name,
+		PhotoURLs: pet.PhotoURLs,
+		Status:    pet.Status,
+		Tags:      pet.Tags,
+	})
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(pet)
+}
+
+func deletePet(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	petstore.RemovePet(int64(id))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func getFile(w http.ResponseWriter, r *http.Request) {
+	http.ServeFile(w, r, r.PathValue("path"))
+}
+
+// petHandler is declared before tagHandler, so its method would be found first if the receiver was ignored.
+type petHandler struct{}
+
+func (h petHandler) list(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(petstore.Pets)
+}
+
+type tagHandler struct {
+	tags []petstore.Tag
+}
+
+func (h *tagHandler) list(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(h.tags)
+}
@@ -0,0 +1,83 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetHTTPInputWithPatterns(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	mux := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithNetHTTPInput(mux, patterns...), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	requirePaths(t, testAstra.Path("paths"))
+}
+
+func TestNetHTTPInputWithDiscovery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithNetHTTPInput(nil), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	requirePaths(t, testAstra.Path("paths"))
+}
+
+func requirePaths(t *testing.T, paths *gabs.Container) {
+	t.Helper()
+
+	// GET /pets
+	require.True(t, paths.Exists("/pets", "get"))
+	require.Equal(t, "array", paths.Path("/pets.get.responses.200.content.application/json.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "status", paths.Path("/pets.get.parameters.0.name").Data().(string))
+	require.Equal(t, "query", paths.Path("/pets.get.parameters.0.in").Data().(string))
+	require.True(t, paths.Exists("/pets", "get", "responses", "200", "headers", "X-Filtered"))
+
+	// POST /pets
+	require.True(t, paths.Exists("/pets", "post"))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets.post.requestBody.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets.post.responses.201.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "string", paths.Path("/pets.post.responses.400.content.text/plain.schema.type").Data().(string))
+
+	// GET /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "get"))
+	require.Equal(t, "id", paths.Path("/pets/{id}.get.parameters.0.name").Data().(string))
+	require.Equal(t, "path", paths.Path("/pets/{id}.get.parameters.0.in").Data().(string))
+	require.Len(t, paths.Path("/pets/{id}.get.parameters").Children(), 1)
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.True(t, paths.Exists("/pets/{id}", "get", "responses", "404"))
+
+	// DELETE /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "delete", "responses", "204"))
+
+	// GET /files/{path...}
+	require.True(t, paths.Exists("/files/{path*}", "get"))
+	require.Equal(t, "path", paths.Path("/files/{path*}.get.parameters.0.name").Data().(string))
+	require.Equal(t, "path", paths.Path("/files/{path*}.get.parameters.0.in").Data().(string))
+	require.True(t, paths.Exists("/files/{path*}", "get", "responses", "200", "content", "application/octet-stream"))
+
+	// GET /tags and GET /pets/all (methods with the same name, with a pointer and a value receiver)
+	require.Equal(t, "#/components/schemas/petstore.Tag", paths.Path("/tags.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/all.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+
+	// GET /{$} (inline handler)
+	require.Equal(t, "string", paths.Path("/.get.responses.200.content.text/plain.schema.type").Data().(string))
+}
@@ -0,0 +1,37 @@
+package petstore
+
+import (
+	"net/http"
+)
+
+var patterns = []string{
+	"GET /pets",
+	"POST /pets",
+	"GET /pets/{id}",
+	"DELETE /pets/{id}",
+	"GET /files/{path...}",
+	"GET /{$}",
+	"GET /tags",
+	"GET /pets/all",
+}
+
+func setupRouter() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /pets", getAllPets)
+	mux.HandleFunc("POST /pets", createPet)
+	mux.HandleFunc("GET /pets/{id}", getPetByID)
+	mux.HandleFunc("DELETE /pets/{id}", deletePet)
+	mux.HandleFunc("GET /files/{path...}", getFile)
+
+	// Both handlers are methods with the same name, on different types
+	tags := &tagHandler{}
+	mux.HandleFunc("GET /tags", tags.list)
+	mux.HandleFunc("GET /pets/all", petHandler{}.list)
+
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	return mux
+}
@@ -0,0 +1,96 @@
+package petstore
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/ls6-events/astra/tests/petstore"
+)
+
+type searchQuery struct {
+	Name   string `query:"name"`
+	Status string `query:"status"`
+}
+
+func getAllPets(c *fiber.Ctx) error {
+	allPets := petstore.Pets
+
+	if c.Query("status") != "" {
+		c.Set("X-Filtered", "true")
+	}
+
+	return c.JSON(allPets)
+}
+
+func searchPets(c *fiber.Ctx) error {
+	var query searchQuery
+	if err := c.QueryParser(&query); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(petstore.Pets)
+}
+
+func getPetByID(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	pet, err := petstore.PetByID(int64(id))
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return c.JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.JSON(pet)
+}
+
+func createPet(c *fiber.Ctx) error {
+	var pet petstore.PetDTO
+	err := c.BodyParser(&pet)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if c.Get("X-Request-ID") == "" {
+		return c.SendStatus(http.StatusPreconditionFailed)
+	}
+
+	petstore.AddPet(petstore.Pet{
+		Name:      pet.Name,
+		PhotoURLs: pet.PhotoURLs,
+		Status:    pet.Status,
+		Tags:      pet.Tags,
+	})
+
+	return c.Status(http.StatusCreated).JSON(pet)
+}
+
+func deletePet(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	petstore.RemovePet(int64(id))
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// petHandler is declared before tagHandler, so its method would be found first if the receiver was ignored.
+type petHandler struct{}
+
+func (h petHandler) list(c *fiber.Ctx) error {
+	return c.JSON(petstore.Pets)
+}
+
+type tagHandler struct {
+	tags []petstore.Tag
+}
+
+func (h *tagHandler) list(c *fiber.Ctx) error {
+	return c.JSON(h.tags)
+}
@@ -0,0 +1,69 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiberInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	app := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithFiberInput(app), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	// GET /pets/
+	require.True(t, paths.Exists("/pets/", "get"))
+	require.False(t, paths.Exists("/pets/", "head"))
+	require.Equal(t, "array", paths.Path("/pets/.get.responses.200.content.application/json.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "status", paths.Path("/pets/.get.parameters.0.name").Data().(string))
+	require.Equal(t, "query", paths.Path("/pets/.get.parameters.0.in").Data().(string))
+	require.True(t, paths.Exists("/pets/", "get", "responses", "200", "headers", "X-Filtered"))
+
+	// GET /pets/search
+	require.True(t, paths.Exists("/pets/search", "get"))
+	require.Len(t, paths.Path("/pets/search.get.parameters").Children(), 2)
+	require.Equal(t, "string", paths.Path("/pets/search.get.responses.400.content.text/plain.schema.type").Data().(string))
+
+	// POST /pets/
+	require.True(t, paths.Exists("/pets/", "post"))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/.post.requestBody.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/pets/.post.responses.201.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/v2.Map", paths.Path("/pets/.post.responses.400.content.application/json.schema.$ref").Data().(string))
+	require.False(t, paths.Exists("/pets/", "post", "responses", "200"))
+	require.True(t, paths.Exists("/pets/", "post", "responses", "412"))
+	require.Equal(t, "X-Request-ID", paths.Path("/pets/.post.parameters.0.name").Data().(string))
+	require.Equal(t, "header", paths.Path("/pets/.post.parameters.0.in").Data().(string))
+
+	// GET /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "get"))
+	require.Equal(t, "id", paths.Path("/pets/{id}.get.parameters.0.name").Data().(string))
+	require.Equal(t, "path", paths.Path("/pets/{id}.get.parameters.0.in").Data().(string))
+	require.Len(t, paths.Path("/pets/{id}.get.parameters").Children(), 1)
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "string", paths.Path("/pets/{id}.get.responses.400.content.text/plain.schema.type").Data().(string))
+	require.Equal(t, "#/components/schemas/v2.Map", paths.Path("/pets/{id}.get.responses.404.content.application/json.schema.$ref").Data().(string))
+
+	// DELETE /pets/{id}
+	require.True(t, paths.Exists("/pets/{id}", "delete", "responses", "204"))
+
+	// GET /tags and GET /pets/all (methods with the same name, with a pointer and a value receiver)
+	require.Equal(t, "#/components/schemas/petstore.Tag", paths.Path("/tags.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/all.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+
+	// GET /health (inline handler)
+	require.Equal(t, "string", paths.Path("/health.get.responses.200.content.text/plain.schema.type").Data().(string))
+}
@@ -0,0 +1,27 @@
+package petstore
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func setupRouter() *fiber.App {
+	app := fiber.New()
+
+	pets := app.Group("/pets")
+	pets.Get("/", getAllPets)
+	pets.Get("/search", searchPets)
+	pets.Get("/:id<int>", getPetByID)
+	pets.Post("/", createPet)
+	pets.Delete("/:id", deletePet)
+
+	// Both handlers are methods with the same name, on different types
+	tags := &tagHandler{}
+	app.Get("/tags", tags.list)
+	app.Get("/pets/all", petHandler{}.list)
+
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	return app
+}
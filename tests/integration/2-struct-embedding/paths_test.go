@@ -20,14 +20,14 @@ func TestPaths(t *testing.T) {
 
 	// GET /cats/{id}
 	require.True(t, paths.Exists("/cats/{id}", "get"))
-	require.Equal(t, "string", paths.Path("/cats/{id}.get.parameters.0.schema.type").Data().(string))
+	require.Equal(t, "integer", paths.Path("/cats/{id}.get.parameters.0.schema.type").Data().(string))
 	require.Equal(t, "#/components/schemas/2-struct-embedding.Cat", paths.Path("/cats/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/cats/{id}.get.responses.400.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/cats/{id}.get.responses.404.content.application/json.schema.$ref").Data().(string))
 
 	// GET /dogs/{id}
 	require.True(t, paths.Exists("/dogs/{id}", "get"))
-	require.Equal(t, "string", paths.Path("/dogs/{id}.get.parameters.0.schema.type").Data().(string))
+	require.Equal(t, "integer", paths.Path("/dogs/{id}.get.parameters.0.schema.type").Data().(string))
 	require.Equal(t, "#/components/schemas/2-struct-embedding.Dog", paths.Path("/dogs/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/dogs/{id}.get.responses.400.content.application/json.schema.$ref").Data().(string))
 	require.Equal(t, "#/components/schemas/gin.H", paths.Path("/dogs/{id}.get.responses.404.content.application/json.schema.$ref").Data().(string))
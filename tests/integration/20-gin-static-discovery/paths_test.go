@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinStaticDiscovery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testAstra, err := helpers.SetupTestAstraWithInput(t, inputs.WithGinStaticInput(), &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	require.Len(t, paths.ChildrenMap(), 6)
+
+	// Routes registered on a router group passed into a function
+	require.True(t, paths.Exists("/api/v1/pets", "get"))
+	require.True(t, paths.Exists("/api/v1/pets", "post"))
+	require.True(t, paths.Exists("/api/v1/pets/{id}", "get"))
+	require.True(t, paths.Exists("/api/v1/pets/{id}", "delete"))
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/api/v1/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.PetDTO", paths.Path("/api/v1/pets.post.requestBody.content.application/json.schema.$ref").Data().(string))
+
+	// Inline handler registered with Handle
+	require.Equal(t, "string", paths.Path("/health.get.responses.200.content.text/plain.schema.type").Data().(string))
+
+	// Handler returned from a function registered with Match
+	require.True(t, paths.Exists("/echo", "get"))
+	require.True(t, paths.Exists("/echo", "post"))
+	require.Equal(t, "message", paths.Path("/echo.get.parameters.0.name").Data().(string))
+
+	// Methods registered as handlers, with a pointer and a value receiver
+	require.Equal(t, "#/components/schemas/petstore.Tag", paths.Path("/tags.get.responses.200.content.application/json.schema.items.$ref").Data().(string))
+	require.Equal(t, "integer", paths.Path("/tags/count.get.responses.200.content.application/json.schema.type").Data().(string))
+
+	// The output should match the one created from the live gin engine
+	r := setupRouter()
+	liveAstra, err := helpers.SetupTestAstra(t, r, &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+	require.NoError(t, err)
+
+	require.JSONEq(t, liveAstra.String(), testAstra.String())
+}
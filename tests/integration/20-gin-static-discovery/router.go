@@ -0,0 +1,39 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	api := r.Group("/api")
+	v1 := api.Group("/v1", authMiddleware)
+	{
+		registerPetRoutes(v1.Group("/pets"))
+	}
+
+	r.Handle(http.MethodGet, "/health", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	r.Match([]string{http.MethodGet, http.MethodPost}, "/echo", echoHandler())
+
+	tags := &tagHandler{}
+	r.GET("/tags", tags.listTags)
+	r.GET("/tags/count", tags.countTags)
+
+	return r
+}
+
+func registerPetRoutes(pets *gin.RouterGroup) {
+	pets.GET("", getAllPets)
+	pets.GET("/:id", getPetByID)
+	pets.POST("", authMiddleware, createPet)
+	pets.DELETE("/:id", deletePet)
+}
+
+func authMiddleware(c *gin.Context) {
+	c.Next()
+}
@@ -0,0 +1,36 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentParsing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	sequentialAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := sequentialAstra.Path("paths")
+	require.Len(t, paths.ChildrenMap(), 4)
+
+	schemas := sequentialAstra.Path("components.schemas")
+	require.True(t, schemas.Exists("petstore.Pet"))
+	require.True(t, schemas.Exists("21-concurrent-parsing.types.TestType"))
+	require.True(t, schemas.Exists("nested.types.TestType"))
+
+	// The output should be the same however the routes are scheduled
+	for i := 0; i < 5; i++ {
+		concurrentAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r, astra.WithConcurrency(4))
+		require.NoError(t, err)
+
+		require.JSONEq(t, sequentialAstra.String(), concurrentAstra.String())
+	}
+}
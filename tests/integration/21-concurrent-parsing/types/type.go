@@ -0,0 +1,5 @@
+package types
+
+type TestType struct {
+	TopLevelField string `json:"topLevelType"`
+}
@@ -0,0 +1,84 @@
+package petstore
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/cache"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalCache(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	cachePath := path.Join(t.TempDir(), "cache.json")
+
+	_, err := helpers.SetupTestAstraWithDefaultConfig(t, r, cache.WithCustomCachePath(cachePath))
+	require.NoError(t, err)
+
+	// Every route should be cached, along with the files it depends on
+	cached := readCache(t, cachePath)
+	require.Len(t, cached.RouteCache, 4)
+	for _, cachedRoute := range cached.RouteCache {
+		require.NotEmpty(t, cachedRoute.Route.ReturnTypes)
+
+		var dependsOnHandlers bool
+		for _, dependency := range cachedRoute.Dependencies {
+			if _, ok := dependency.Files["handlers.go"]; ok {
+				dependsOnHandlers = true
+			}
+		}
+		require.True(t, dependsOnHandlers)
+	}
+
+	// Change a cached route so we can tell whether it is reused
+	for i, cachedRoute := range cached.RouteCache {
+		if cachedRoute.Route.Path == "/pets" && cachedRoute.Route.Method == "GET" {
+			cached.RouteCache[i].Route.Doc = "From the route cache"
+		}
+	}
+	writeCache(t, cachePath, cached)
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r, cache.WithCustomCachePath(cachePath))
+	require.NoError(t, err)
+
+	require.Equal(t, "From the route cache", testAstra.Path("paths./pets.get.description").Data().(string))
+	require.Equal(t, "#/components/schemas/petstore.Pet", testAstra.Path("paths./pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+	require.True(t, testAstra.Exists("components", "schemas", "petstore.Pet"))
+
+	// Forcing a rebuild should parse every route again
+	testAstra, err = helpers.SetupTestAstraWithDefaultConfig(t, r, cache.WithCustomCachePath(cachePath), cache.WithForceRebuild())
+	require.NoError(t, err)
+
+	require.False(t, testAstra.Exists("paths", "/pets", "get", "description"))
+	require.Len(t, readCache(t, cachePath).RouteCache, 4)
+}
+
+func readCache(t *testing.T, cachePath string) astra.Service {
+	t.Helper()
+
+	contents, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+
+	var service astra.Service
+	require.NoError(t, json.Unmarshal(contents, &service))
+
+	return service
+}
+
+func writeCache(t *testing.T, cachePath string, service astra.Service) {
+	t.Helper()
+
+	contents, err := json.Marshal(service)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(cachePath, contents, 0644))
+}
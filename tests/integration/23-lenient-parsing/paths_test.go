@@ -0,0 +1,118 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLenientParsing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	gen := astra.New(inputs.WithGinInput(r), outputs.WithOpenAPIOutput("./output.json"), astra.WithLenientParsing())
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.SetupParse()
+	require.NoError(t, err)
+
+	// A route whose handler is in a file that doesn't exist
+	gen.AddRoute(astra.Route{
+		Handler: "github.com/ls6-events/astra/tests/integration/23-lenient-parsing.missingHandler",
+		File:    "missing.go",
+		LineNo:  10,
+		Method:  "GET",
+		Path:    "/missing",
+	})
+
+	err = gen.CompleteParse()
+	require.NoError(t, err)
+
+	require.Len(t, gen.Diagnostics, 2)
+
+	// The handler whose status code can't be parsed
+	require.Equal(t, "/pets/:id", gen.Diagnostics[0].Path)
+	require.Equal(t, "PUT", gen.Diagnostics[0].Method)
+	require.Equal(t, "handlers.go", gen.Diagnostics[0].File)
+	require.ErrorContains(t, gen.Diagnostics[0].Cause, "value not retrievable")
+
+	require.Equal(t, "/missing", gen.Diagnostics[1].Path)
+	require.Equal(t, "GET", gen.Diagnostics[1].Method)
+	require.Equal(t, "missing.go", gen.Diagnostics[1].File)
+	require.Equal(t, 10, gen.Diagnostics[1].Line)
+	require.ErrorContains(t, gen.Diagnostics[1].Cause, "could not find file")
+
+	fileContents, err := os.ReadFile("./output.json")
+	require.NoError(t, err)
+
+	testAstra, err := gabs.ParseJSON(fileContents)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	// The rest of the routes are still generated
+	require.Len(t, paths.ChildrenMap(), 3)
+	require.Equal(t, "#/components/schemas/petstore.Pet", paths.Path("/pets/{id}.get.responses.200.content.application/json.schema.$ref").Data().(string))
+
+	// The failed routes are kept
+	require.True(t, paths.Exists("/pets/{id}", "put"))
+	require.True(t, paths.Exists("/missing", "get"))
+}
+
+func TestStrictParsing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	gen := astra.New(inputs.WithGinInput(r), outputs.WithOpenAPIOutput("./output.json"))
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.SetupParse()
+	require.NoError(t, err)
+
+	err = gen.CompleteParse()
+	require.ErrorContains(t, err, "value not retrievable")
+}
+
+func TestStrictParsingMissingHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(inputs.WithGinInput(gin.New()), outputs.WithOpenAPIOutput("./output.json"))
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.SetupParse()
+	require.NoError(t, err)
+
+	gen.AddRoute(astra.Route{
+		Handler: "github.com/ls6-events/astra/tests/integration/23-lenient-parsing.missingHandler",
+		File:    "missing.go",
+		LineNo:  10,
+		Method:  "GET",
+		Path:    "/missing",
+	})
+
+	err = gen.CompleteParse()
+	require.ErrorContains(t, err, "could not find file")
+}
@@ -0,0 +1,59 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	properties := testAstra.Path("components.schemas").Search("24-validation-tags.CreateUserRequest", "properties")
+
+	t.Run("Strings", func(t *testing.T) {
+		require.Equal(t, 3.0, properties.Path("name.minLength").Data().(float64))
+		require.Equal(t, 20.0, properties.Path("name.maxLength").Data().(float64))
+		require.Equal(t, "^[a-zA-Z0-9]+$", properties.Path("name.pattern").Data().(string))
+
+		require.Equal(t, "email", properties.Path("email.format").Data().(string))
+		require.Equal(t, "uri", properties.Path("website.format").Data().(string))
+
+		require.Equal(t, 4.0, properties.Path("nickname.minLength").Data().(float64))
+		require.Equal(t, 4.0, properties.Path("nickname.maxLength").Data().(float64))
+	})
+
+	t.Run("Numbers", func(t *testing.T) {
+		require.Equal(t, 0.0, properties.Path("age.minimum").Data().(float64))
+		require.Equal(t, 150.0, properties.Path("age.maximum").Data().(float64))
+		require.True(t, properties.Path("age.exclusiveMaximum").Data().(bool))
+
+		require.Equal(t, 0.0, properties.Path("score.minimum").Data().(float64))
+		require.True(t, properties.Path("score.exclusiveMinimum").Data().(bool))
+		require.Equal(t, 10.0, properties.Path("score.maximum").Data().(float64))
+	})
+
+	t.Run("Enums", func(t *testing.T) {
+		require.Equal(t, []any{"admin", "user", "guest"}, properties.Path("role.enum").Data().([]any))
+		require.Equal(t, []any{1.0, 2.0, 3.0}, properties.Path("level.enum").Data().([]any))
+	})
+
+	t.Run("Slices", func(t *testing.T) {
+		require.Equal(t, 1.0, properties.Path("friends.minItems").Data().(float64))
+		require.Equal(t, 5.0, properties.Path("friends.maxItems").Data().(float64))
+		require.Equal(t, "uuid", properties.Path("friends.items.format").Data().(string))
+	})
+
+	t.Run("Named types", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/24-validation-tags.Tags", properties.Path("tags.allOf.0.$ref").Data().(string))
+		require.Equal(t, 10.0, properties.Path("tags.maxItems").Data().(float64))
+	})
+}
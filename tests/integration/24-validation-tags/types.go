@@ -0,0 +1,22 @@
+package petstore
+
+type Tags []string
+
+type CreateUserRequest struct {
+	Name     string   `json:"name" binding:"required,min=3,max=20,alphanum"`
+	Email    string   `json:"email" binding:"required,email"`
+	Age      int      `json:"age" binding:"gte=0,lt=150"`
+	Score    float64  `json:"score" validate:"gt=0,lte=10"`
+	Role     string   `json:"role" binding:"oneof=admin user guest"`
+	Level    int      `json:"level" binding:"oneof=1 2 3"`
+	Website  string   `json:"website" binding:"omitempty,url"`
+	Friends  []string `json:"friends" binding:"min=1,max=5,dive,uuid"`
+	Tags     Tags     `json:"tags" validate:"max=10"`
+	Nickname string   `json:"nickname" binding:"len=4"`
+}
+
+type User struct {
+	ID    string `json:"id" binding:"uuid"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
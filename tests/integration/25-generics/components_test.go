@@ -0,0 +1,61 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerics(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+	paths := testAstra.Path("paths")
+
+	t.Run("Each instantiation is its own component", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/25-generics.PagePost", paths.Path("/posts.get.responses.200.content.application/json.schema.$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/25-generics.PageUser", paths.Path("/users.get.responses.200.content.application/json.schema.$ref").Data().(string))
+
+		pagePost := schemas.Search("25-generics.PagePost")
+		require.Equal(t, "Page is a page of items.", pagePost.Path("description").Data().(string))
+		require.Equal(t, "#/components/schemas/25-generics.Post", pagePost.Path("properties.items.items.$ref").Data().(string))
+		require.Equal(t, "integer", pagePost.Path("properties.total.type").Data().(string))
+
+		pageUser := schemas.Search("25-generics.PageUser")
+		require.Equal(t, "#/components/schemas/25-generics.User", pageUser.Path("properties.items.items.$ref").Data().(string))
+
+		require.False(t, schemas.Exists("25-generics.Page"))
+	})
+
+	t.Run("Multiple type arguments", func(t *testing.T) {
+		pair := schemas.Search("25-generics.PairStringInt")
+		require.Equal(t, "integer", pair.Path("properties.values.additionalProperties.type").Data().(string))
+		require.Equal(t, "string", pair.Path("properties.first.type").Data().(string))
+	})
+
+	t.Run("Nested instantiations", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/25-generics.ResponsePagePost", paths.Path("/posts/response.get.responses.200.content.application/json.schema.$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/25-generics.PagePost", schemas.Search("25-generics.ResponsePagePost", "properties", "data", "$ref").Data().(string))
+	})
+
+	t.Run("Type arguments with the same name from different packages", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/25-generics.PagePet", paths.Path("/pets.get.responses.200.content.application/json.schema.$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/25-generics.Pet", schemas.Search("25-generics.PagePet", "properties", "items", "items", "$ref").Data().(string))
+
+		require.Equal(t, "#/components/schemas/25-generics.PagePetstorePet", paths.Path("/store/pets.get.responses.200.content.application/json.schema.$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/petstore.Pet", schemas.Search("25-generics.PagePetstorePet", "properties", "items", "items", "$ref").Data().(string))
+	})
+
+	t.Run("Request bodies", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/25-generics.ResponsePost", paths.Path("/posts.post.requestBody.content.application/json.schema.$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/25-generics.Post", schemas.Search("25-generics.ResponsePost", "properties", "data", "$ref").Data().(string))
+	})
+}
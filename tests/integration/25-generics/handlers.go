@@ -0,0 +1,50 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ls6-events/astra/tests/petstore"
+)
+
+func getPosts(c *gin.Context) {
+	c.JSON(http.StatusOK, Page[Post]{})
+}
+
+func getUsers(c *gin.Context) {
+	c.JSON(http.StatusOK, newPage([]User{}))
+}
+
+func getPair(c *gin.Context) {
+	c.JSON(http.StatusOK, Pair[string, int]{})
+}
+
+func getPostsResponse(c *gin.Context) {
+	c.JSON(http.StatusOK, Response[Page[Post]]{})
+}
+
+func createPost(c *gin.Context) {
+	var req Response[Post]
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, req.Data)
+}
+
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, Page[Pet]{})
+}
+
+func getStorePets(c *gin.Context) {
+	c.JSON(http.StatusOK, Page[petstore.Pet]{})
+}
+
+func newPage[T any](items []T) Page[T] {
+	return Page[T]{
+		Items: items,
+		Total: len(items),
+	}
+}
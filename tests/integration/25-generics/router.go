@@ -0,0 +1,17 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/posts", getPosts)
+	r.GET("/users", getUsers)
+	r.GET("/pair", getPair)
+	r.GET("/posts/response", getPostsResponse)
+	r.POST("/posts", createPost)
+	r.GET("/pets", getPets)
+	r.GET("/store/pets", getStorePets)
+
+	return r
+}
@@ -0,0 +1,30 @@
+package petstore
+
+// Page is a page of items.
+type Page[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
+
+// Response is a response envelope.
+type Response[T any] struct {
+	Data T `json:"data"`
+}
+
+type Pair[K comparable, V any] struct {
+	Values map[K]V `json:"values"`
+	First  K       `json:"first"`
+}
+
+type Post struct {
+	Title string `json:"title"`
+}
+
+type User struct {
+	Name string `json:"name"`
+}
+
+// Pet has the same name as petstore.Pet, so a page of each needs a different name.
+type Pet struct {
+	Nickname string `json:"nickname"`
+}
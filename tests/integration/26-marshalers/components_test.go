@@ -0,0 +1,51 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r, astra.WithCustomTypeMappingSingle("github.com/ls6-events/astra/tests/integration/26-marshalers.Timestamp", "integer", "int64"))
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+
+	t.Run("Text marshalers", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("26-marshalers.Money", "type").Data().(string))
+		require.False(t, schemas.Exists("26-marshalers.Money", "properties"))
+		require.Equal(t, "Money is an amount in pence, serialised as a decimal string.", schemas.Search("26-marshalers.Money", "description").Data().(string))
+
+		require.Equal(t, "string", schemas.Search("26-marshalers.ID", "type").Data().(string))
+		require.False(t, schemas.Exists("26-marshalers.ID", "items"))
+
+		require.Equal(t, "string", schemas.Search("uuid.UUID", "type").Data().(string))
+		require.Equal(t, "uuid", schemas.Search("uuid.UUID", "format").Data().(string))
+	})
+
+	t.Run("JSON marshalers", func(t *testing.T) {
+		// The custom type mapping is used
+		require.Equal(t, "integer", schemas.Search("26-marshalers.Timestamp", "type").Data().(string))
+		require.Equal(t, "int64", schemas.Search("26-marshalers.Timestamp", "format").Data().(string))
+
+		// The fallback is used, which is any type by default (even though it also implements encoding.TextMarshaler)
+		require.False(t, schemas.Exists("26-marshalers.Metadata", "type"))
+		require.False(t, schemas.Exists("26-marshalers.Metadata", "properties"))
+	})
+
+	t.Run("Configured fallback", func(t *testing.T) {
+		testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r, astra.WithJSONMarshalerFallback("object", ""))
+		require.NoError(t, err)
+
+		require.Equal(t, "object", testAstra.Path("components.schemas").Search("26-marshalers.Metadata", "type").Data().(string))
+	})
+}
@@ -0,0 +1,11 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func getOrder(c *gin.Context) {
+	c.JSON(http.StatusOK, Order{})
+}
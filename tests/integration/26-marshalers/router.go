@@ -0,0 +1,11 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/order", getOrder)
+
+	return r
+}
@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Money is an amount in pence, serialised as a decimal string.
+type Money struct {
+	Pence int64
+}
+
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d.%02d", m.Pence/100, m.Pence%100)), nil
+}
+
+// ID is serialised as a hex string.
+type ID [8]byte
+
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(id[:])), nil
+}
+
+// Timestamp is serialised as the number of seconds since the epoch.
+type Timestamp struct {
+	time.Time
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Unix())
+}
+
+// Metadata is serialised as whatever the values are.
+type Metadata struct {
+	values map[string]any
+}
+
+func (m *Metadata) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.values)
+}
+
+func (m *Metadata) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprint(m.values)), nil
+}
+
+type Order struct {
+	ID        ID        `json:"id"`
+	Reference uuid.UUID `json:"reference"`
+	Total     Money     `json:"total"`
+	CreatedAt Timestamp `json:"createdAt"`
+	Metadata  Metadata  `json:"metadata"`
+}
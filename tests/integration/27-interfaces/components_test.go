@@ -0,0 +1,60 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+const pkgPath = "github.com/ls6-events/astra/tests/integration/27-interfaces"
+
+func TestInterfaces(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r,
+		astra.WithInterfaceImplementations(pkgPath+".Event", pkgPath+".PetAdopted", pkgPath+".PetVaccinated"),
+		astra.WithInterfaceDiscovery(),
+		astra.WithInterfaceDiscriminator(pkgPath+".Notification", "channel", "Channel"),
+	)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+
+	t.Run("Registered implementations", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/27-interfaces.Event", schemas.Search("27-interfaces.EventEnvelope", "properties", "event", "$ref").Data().(string))
+
+		oneOf := schemas.Search("27-interfaces.Event", "oneOf").Children()
+		require.Len(t, oneOf, 2)
+		require.Equal(t, "#/components/schemas/27-interfaces.PetAdopted", oneOf[0].Search("$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.PetVaccinated", oneOf[1].Search("$ref").Data().(string))
+
+		require.Equal(t, "type", schemas.Search("27-interfaces.Event", "discriminator", "propertyName").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.PetAdopted", schemas.Search("27-interfaces.Event", "discriminator", "mapping", "pet.adopted").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.PetVaccinated", schemas.Search("27-interfaces.Event", "discriminator", "mapping", "pet.vaccinated").Data().(string))
+
+		require.Equal(t, "object", schemas.Search("27-interfaces.PetAdopted", "type").Data().(string))
+		require.True(t, schemas.Exists("27-interfaces.PetVaccinated", "properties", "vaccine"))
+	})
+
+	t.Run("Discovered implementations", func(t *testing.T) {
+		oneOf := schemas.Search("27-interfaces.Notification", "oneOf").Children()
+		require.Len(t, oneOf, 2)
+		require.Equal(t, "#/components/schemas/27-interfaces.EmailNotification", oneOf[0].Search("$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.SMSNotification", oneOf[1].Search("$ref").Data().(string))
+
+		require.Equal(t, "channel", schemas.Search("27-interfaces.Notification", "discriminator", "propertyName").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.EmailNotification", schemas.Search("27-interfaces.Notification", "discriminator", "mapping", "email").Data().(string))
+		require.Equal(t, "#/components/schemas/27-interfaces.SMSNotification", schemas.Search("27-interfaces.Notification", "discriminator", "mapping", "sms").Data().(string))
+	})
+
+	t.Run("Interfaces without implementations", func(t *testing.T) {
+		require.True(t, schemas.Exists("27-interfaces.Extra"))
+		require.False(t, schemas.Exists("27-interfaces.Extra", "oneOf"))
+	})
+}
@@ -0,0 +1,15 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func getEvent(c *gin.Context) {
+	c.JSON(http.StatusOK, EventEnvelope{})
+}
+
+func getNotifications(c *gin.Context) {
+	c.JSON(http.StatusOK, NotificationResponse{})
+}
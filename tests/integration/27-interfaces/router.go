@@ -0,0 +1,12 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/events/:id", getEvent)
+	r.GET("/notifications", getNotifications)
+
+	return r
+}
@@ -0,0 +1,72 @@
+package petstore
+
+import "time"
+
+// Event is something that happened to a pet.
+type Event interface {
+	OccurredAt() time.Time
+}
+
+type PetAdopted struct {
+	Type    string    `json:"type" discriminator:"pet.adopted"`
+	PetID   int       `json:"petId"`
+	OwnerID int       `json:"ownerId"`
+	At      time.Time `json:"at"`
+}
+
+func (e PetAdopted) OccurredAt() time.Time {
+	return e.At
+}
+
+type PetVaccinated struct {
+	Type    string    `json:"type" discriminator:"pet.vaccinated"`
+	PetID   int       `json:"petId"`
+	Vaccine string    `json:"vaccine"`
+	At      time.Time `json:"at"`
+}
+
+func (e *PetVaccinated) OccurredAt() time.Time {
+	return e.At
+}
+
+type EventEnvelope struct {
+	ID    string `json:"id"`
+	Event Event  `json:"event"`
+}
+
+const (
+	channelEmail = "email"
+	channelSMS   = "sms"
+)
+
+// Notification is sent to the owner of a pet.
+type Notification interface {
+	Channel() string
+}
+
+type EmailNotification struct {
+	Address string `json:"address"`
+	Subject string `json:"subject"`
+}
+
+func (n EmailNotification) Channel() string {
+	return channelEmail
+}
+
+type SMSNotification struct {
+	Number string `json:"number"`
+}
+
+func (n SMSNotification) Channel() string {
+	return channelSMS
+}
+
+// Extra can be anything, as nothing implements it.
+type Extra interface {
+	Extra()
+}
+
+type NotificationResponse struct {
+	Notifications []Notification `json:"notifications"`
+	Extra         Extra          `json:"extra"`
+}
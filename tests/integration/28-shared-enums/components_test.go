@@ -0,0 +1,40 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedEnums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+
+	t.Run("String enum from another package", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/domain.Status", schemas.Search("28-shared-enums.Task", "properties", "status", "$ref").Data().(string))
+
+		require.Equal(t, "string", schemas.Search("domain.Status", "type").Data().(string))
+		require.Equal(t, []any{"open", "closed"}, schemas.Search("domain.Status", "enum").Data().([]any))
+		require.False(t, schemas.Exists("domain.Status", "x-enum-varnames"))
+	})
+
+	t.Run("Iota enum with a String method", func(t *testing.T) {
+		require.Equal(t, "integer", schemas.Search("domain.Priority", "type").Data().(string))
+		require.Equal(t, []any{0.0, 1.0, 2.0}, schemas.Search("domain.Priority", "enum").Data().([]any))
+		require.Equal(t, []any{"low", "medium", "high"}, schemas.Search("domain.Priority", "x-enum-varnames").Data().([]any))
+	})
+
+	t.Run("Iota enum without a String method", func(t *testing.T) {
+		require.Equal(t, []any{1.0, 2.0, 3.0}, schemas.Search("domain.Weekday", "enum").Data().([]any))
+		require.Equal(t, []any{"Monday", "Tuesday", "Wednesday"}, schemas.Search("domain.Weekday", "x-enum-varnames").Data().([]any))
+	})
+}
@@ -0,0 +1,9 @@
+package petstore
+
+import "github.com/ls6-events/astra/tests/integration/28-shared-enums/domain"
+
+type Task struct {
+	Status   domain.Status   `json:"status"`
+	Priority domain.Priority `json:"priority"`
+	Due      domain.Weekday  `json:"due"`
+}
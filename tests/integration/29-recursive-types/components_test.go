@@ -0,0 +1,42 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecursiveTypes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+
+	t.Run("Direct recursion", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/29-recursive-types.Category", schemas.Search("29-recursive-types.Category", "properties", "parent", "allOf", "0", "$ref").Data().(string))
+		require.Equal(t, "array", schemas.Search("29-recursive-types.Category", "properties", "children", "type").Data().(string))
+		require.Equal(t, "#/components/schemas/29-recursive-types.Category", schemas.Search("29-recursive-types.Category", "properties", "children", "items", "$ref").Data().(string))
+	})
+
+	t.Run("Mutual recursion", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/29-recursive-types.Pet", schemas.Search("29-recursive-types.Owner", "properties", "pets", "items", "$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/29-recursive-types.Owner", schemas.Search("29-recursive-types.Pet", "properties", "owner", "allOf", "0", "$ref").Data().(string))
+	})
+
+	t.Run("Map recursion", func(t *testing.T) {
+		require.Equal(t, "object", schemas.Search("29-recursive-types.Directory", "properties", "directories", "type").Data().(string))
+		require.Equal(t, "#/components/schemas/29-recursive-types.Directory", schemas.Search("29-recursive-types.Directory", "properties", "directories", "additionalProperties", "$ref").Data().(string))
+	})
+
+	t.Run("Generic recursion", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("29-recursive-types.NodeString", "properties", "value", "type").Data().(string))
+		require.Equal(t, "#/components/schemas/29-recursive-types.NodeString", schemas.Search("29-recursive-types.NodeString", "properties", "children", "items", "$ref").Data().(string))
+	})
+}
@@ -0,0 +1,27 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func getCategories(c *gin.Context) {
+	c.JSON(http.StatusOK, []Category{})
+}
+
+func getOwner(c *gin.Context) {
+	c.JSON(http.StatusOK, Owner{})
+}
+
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func getDirectory(c *gin.Context) {
+	c.JSON(http.StatusOK, Directory{})
+}
+
+func getTree(c *gin.Context) {
+	c.JSON(http.StatusOK, Node[string]{})
+}
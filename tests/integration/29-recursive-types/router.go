@@ -0,0 +1,15 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/categories", getCategories)
+	r.GET("/owner", getOwner)
+	r.GET("/pet", getPet)
+	r.GET("/directory", getDirectory)
+	r.GET("/tree", getTree)
+
+	return r
+}
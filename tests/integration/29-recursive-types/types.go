@@ -0,0 +1,31 @@
+package petstore
+
+// Category is a tree of categories.
+type Category struct {
+	Name     string     `json:"name"`
+	Parent   *Category  `json:"parent,omitempty"`
+	Children []Category `json:"children"`
+}
+
+// Owner has pets, which have owners.
+type Owner struct {
+	Name string `json:"name"`
+	Pets []Pet  `json:"pets"`
+}
+
+type Pet struct {
+	Name  string `json:"name"`
+	Owner *Owner `json:"owner"`
+}
+
+// Directory is a tree of directories, keyed by their name.
+type Directory struct {
+	Files       []string             `json:"files"`
+	Directories map[string]Directory `json:"directories"`
+}
+
+// Node is a generic tree.
+type Node[T any] struct {
+	Value    T         `json:"value"`
+	Children []Node[T] `json:"children"`
+}
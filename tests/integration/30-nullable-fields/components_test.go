@@ -0,0 +1,45 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableFields(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	properties := testAstra.Path("components.schemas").Search("30-nullable-fields.Pet", "properties")
+
+	t.Run("Pointers", func(t *testing.T) {
+		require.Equal(t, "string", properties.Search("nickname", "type").Data().(string))
+		require.True(t, properties.Search("nickname", "nullable").Data().(bool))
+
+		require.Equal(t, "integer", properties.Search("age", "type").Data().(string))
+		require.True(t, properties.Search("age", "nullable").Data().(bool))
+
+		require.Equal(t, "#/components/schemas/30-nullable-fields.Owner", properties.Search("owner", "allOf", "0", "$ref").Data().(string))
+		require.True(t, properties.Search("owner", "nullable").Data().(bool))
+
+		require.False(t, properties.Exists("name", "nullable"))
+		require.False(t, properties.Exists("friends", "nullable"))
+	})
+
+	t.Run("String encoded values", func(t *testing.T) {
+		require.Equal(t, "string", properties.Search("id", "type").Data().(string))
+		require.Equal(t, "int64", properties.Search("id", "format").Data().(string))
+
+		require.Equal(t, "string", properties.Search("weight", "type").Data().(string))
+		require.Equal(t, "float64", properties.Search("weight", "format").Data().(string))
+
+		require.Equal(t, "string", properties.Search("vaccinated", "type").Data().(string))
+	})
+}
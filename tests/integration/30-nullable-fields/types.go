@@ -0,0 +1,16 @@
+package petstore
+
+type Owner struct {
+	Name string `json:"name"`
+}
+
+type Pet struct {
+	ID         int64   `json:"id,string"`
+	Name       string  `json:"name"`
+	Nickname   *string `json:"nickname"`
+	Age        *int    `json:"age,omitempty"`
+	Weight     float64 `json:"weight,string,omitempty"`
+	Vaccinated bool    `json:"vaccinated,string"`
+	Owner      *Owner  `json:"owner"`
+	Friends    []Pet   `json:"friends"`
+}
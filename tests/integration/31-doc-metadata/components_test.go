@@ -0,0 +1,51 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocMetadata(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+	properties := schemas.Search("31-doc-metadata.Pet", "properties")
+
+	t.Run("Examples", func(t *testing.T) {
+		require.Equal(t, "Rex", properties.Search("name", "example").Data().(string))
+		require.Equal(t, 3.0, properties.Search("age", "example").Data().(float64))
+		require.False(t, properties.Exists("age", "examples"))
+
+		require.Equal(t, "#/components/schemas/31-doc-metadata.Owner", properties.Search("owner", "allOf", "0", "$ref").Data().(string))
+		require.Equal(t, "Sam", properties.Search("owner", "example", "name").Data().(string))
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		require.Equal(t, 1.0, properties.Search("age", "default").Data().(float64))
+		require.Equal(t, false, properties.Search("vaccinated", "default").Data().(bool))
+	})
+
+	t.Run("Deprecated fields and types", func(t *testing.T) {
+		require.True(t, properties.Search("tag", "deprecated").Data().(bool))
+		require.False(t, properties.Exists("tags", "deprecated"))
+
+		require.True(t, schemas.Search("31-doc-metadata.Owner", "deprecated").Data().(bool))
+		require.Equal(t, "Owner is the owner of a pet.\n\nDeprecated: pets are no longer owned.", schemas.Search("31-doc-metadata.Owner", "description").Data().(string))
+	})
+
+	t.Run("Deprecated operations", func(t *testing.T) {
+		paths := testAstra.Path("paths")
+
+		require.True(t, paths.Search("/pet/legacy", "get", "deprecated").Data().(bool))
+		require.False(t, paths.Exists("/pet", "get", "deprecated"))
+	})
+}
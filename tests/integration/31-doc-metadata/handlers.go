@@ -0,0 +1,19 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getPet gets a pet.
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
+
+// getPetLegacy gets a pet from the old endpoint.
+//
+// Deprecated: use GET /pet instead.
+func getPetLegacy(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
@@ -0,0 +1,30 @@
+package petstore
+
+// Owner is the owner of a pet.
+//
+// Deprecated: pets are no longer owned.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+type Pet struct {
+	// Name is the name of the pet.
+	// Example: Rex
+	Name string `json:"name"`
+	// Age is the age of the pet in years.
+	// Example: 3
+	// Example: 5
+	// Default: 1
+	Age int `json:"age"`
+	// Vaccinated is whether the pet has been vaccinated.
+	// default: false
+	Vaccinated bool `json:"vaccinated"`
+	// Tag is the tag of the pet.
+	//
+	// Deprecated: use Tags instead.
+	Tag  string   `json:"tag"`
+	Tags []string `json:"tags"`
+	// Owner is the owner of the pet.
+	// Example: {"name": "Sam"}
+	Owner Owner `json:"owner"`
+}
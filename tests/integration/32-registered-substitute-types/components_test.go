@@ -0,0 +1,80 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredSubstituteTypes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r,
+		astra.WithSubstituteType("database/sql.NullString", astra.Field{Type: "string"}),
+		astra.WithSubstituteType("encoding/json.RawMessage", astra.Field{Type: "any"}),
+		astra.WithSubstituteType("github.com/ls6-events/astra/tests/integration/32-registered-substitute-types.Tags", astra.Field{
+			Type:      "slice",
+			SliceType: "string",
+		}),
+		astra.WithSubstituteType("github.com/ls6-events/astra/tests/integration/32-registered-substitute-types.Point", astra.Field{
+			Type: "struct",
+			StructFields: map[string]astra.Field{
+				"Lat": {
+					Name: "Lat",
+					Type: "float64",
+					StructFieldBindingTags: astTraversal.BindingTagMap{
+						astTraversal.JSONBindingTag: {Name: "lat"},
+					},
+				},
+				"Lng": {
+					Name: "Lng",
+					Type: "float64",
+					StructFieldBindingTags: astTraversal.BindingTagMap{
+						astTraversal.JSONBindingTag: {Name: "lng"},
+					},
+				},
+			},
+		}),
+		astra.WithSubstituteType("time.Duration", astra.Field{Type: "string"}),
+	)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+	properties := schemas.Search("32-registered-substitute-types.Pet", "properties")
+
+	t.Run("Primitive replacements", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/sql.NullString", properties.Search("name", "$ref").Data().(string))
+		require.Equal(t, "string", schemas.Search("sql.NullString", "type").Data().(string))
+		require.False(t, schemas.Exists("sql.NullString", "properties"))
+
+		require.Equal(t, "#/components/schemas/json.RawMessage", properties.Search("attributes", "$ref").Data().(string))
+		require.False(t, schemas.Exists("json.RawMessage", "type"))
+	})
+
+	t.Run("Slice replacement", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/32-registered-substitute-types.Tags", properties.Search("tags", "$ref").Data().(string))
+		require.Equal(t, "array", schemas.Search("32-registered-substitute-types.Tags", "type").Data().(string))
+		require.Equal(t, "string", schemas.Search("32-registered-substitute-types.Tags", "items", "type").Data().(string))
+	})
+
+	t.Run("Struct replacement", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/32-registered-substitute-types.Point", properties.Search("location", "$ref").Data().(string))
+
+		point := schemas.Search("32-registered-substitute-types.Point")
+		require.Equal(t, "object", point.Search("type").Data().(string))
+		require.Equal(t, "number", point.Search("properties", "lat", "type").Data().(string))
+		require.Equal(t, "number", point.Search("properties", "lng", "type").Data().(string))
+		require.Equal(t, "Point is a location, which is serialised by the database driver.", point.Search("description").Data().(string))
+	})
+
+	t.Run("Registered substitute types take precedence", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("time.Duration", "type").Data().(string))
+	})
+}
@@ -0,0 +1,23 @@
+package petstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// Point is a location, which is serialised by the database driver.
+type Point struct {
+	x, y float64
+}
+
+// Tags is a comma separated list of tags.
+type Tags string
+
+type Pet struct {
+	Name       sql.NullString  `json:"name"`
+	Attributes json.RawMessage `json:"attributes"`
+	Location   Point           `json:"location"`
+	Tags       Tags            `json:"tags"`
+	Lifespan   time.Duration   `json:"lifespan"`
+}
@@ -0,0 +1,58 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypePresets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r,
+		astra.WithTypePreset(astra.PresetCommon),
+		astra.WithCustomTypeMappingSingle("database/sql.NullInt64", "string", "chip"),
+	)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+
+	t.Run("SQL types", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("sql.NullString", "type").Data().(string))
+		require.True(t, schemas.Search("sql.NullString", "nullable").Data().(bool))
+		require.False(t, schemas.Exists("sql.NullString", "properties"))
+
+		require.Equal(t, "number", schemas.Search("sql.NullFloat64", "type").Data().(string))
+		require.True(t, schemas.Search("sql.NullFloat64", "nullable").Data().(bool))
+
+		require.Equal(t, "date-time", schemas.Search("sql.NullTime", "format").Data().(string))
+		require.True(t, schemas.Search("sql.NullTime", "nullable").Data().(bool))
+	})
+
+	t.Run("UUID types", func(t *testing.T) {
+		require.Equal(t, "uuid", schemas.Search("uuid.UUID", "format").Data().(string))
+		require.False(t, schemas.Exists("uuid.UUID", "nullable"))
+
+		require.Equal(t, "uuid", schemas.Search("uuid.NullUUID", "format").Data().(string))
+		require.True(t, schemas.Search("uuid.NullUUID", "nullable").Data().(bool))
+	})
+
+	t.Run("Network types", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("netip.Addr", "type").Data().(string))
+		require.False(t, schemas.Exists("netip.Addr", "format"))
+		require.Equal(t, "ipv4", schemas.Search("netip.Addr", "anyOf", "0", "format").Data().(string))
+		require.Equal(t, "ipv6", schemas.Search("netip.Addr", "anyOf", "1", "format").Data().(string))
+	})
+
+	t.Run("Custom type mappings take precedence", func(t *testing.T) {
+		require.Equal(t, "string", schemas.Search("sql.NullInt64", "type").Data().(string))
+		require.Equal(t, "chip", schemas.Search("sql.NullInt64", "format").Data().(string))
+		require.False(t, schemas.Exists("sql.NullInt64", "nullable"))
+	})
+}
@@ -0,0 +1,20 @@
+package petstore
+
+import (
+	"database/sql"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Pet struct {
+	ID        uuid.UUID       `json:"id"`
+	OwnerID   uuid.NullUUID   `json:"ownerId"`
+	Name      sql.NullString  `json:"name"`
+	Weight    sql.NullFloat64 `json:"weight"`
+	AdoptedAt sql.NullTime    `json:"adoptedAt"`
+	BornAt    time.Time       `json:"bornAt"`
+	ChipID    sql.NullInt64   `json:"chipId"`
+	Clinic    netip.Addr      `json:"clinic"`
+}
@@ -0,0 +1,97 @@
+package petstore
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+// propertyNames finds the names of the properties of a schema, including the properties of the schemas it references in allOf.
+func propertyNames(schemas *gabs.Container, schema *gabs.Container) []string {
+	names := make([]string, 0)
+	for name := range schema.Search("properties").ChildrenMap() {
+		names = append(names, name)
+	}
+
+	for _, allOf := range schema.Search("allOf").Children() {
+		if ref, ok := allOf.Search("$ref").Data().(string); ok {
+			names = append(names, propertyNames(schemas, schemas.Search(strings.TrimPrefix(ref, "#/components/schemas/")))...)
+		} else {
+			names = append(names, propertyNames(schemas, allOf)...)
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func TestEmbeddedPromotion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	schemas := testAstra.Path("components.schemas")
+	pet := schemas.Search("34-embedded-promotion.Pet")
+
+	t.Run("Matches json.Marshal", func(t *testing.T) {
+		output, err := json.Marshal(Pet{Owner: &Owner{}})
+		require.NoError(t, err)
+
+		var keys map[string]any
+		require.NoError(t, json.Unmarshal(output, &keys))
+
+		expected := make([]string, 0, len(keys))
+		for key := range keys {
+			expected = append(expected, key)
+		}
+		slices.Sort(expected)
+
+		require.Equal(t, expected, propertyNames(schemas, pet))
+	})
+
+	t.Run("References embedded structs whose fields are all shown", func(t *testing.T) {
+		refs := make([]string, 0)
+		for _, allOf := range pet.Search("allOf").Children() {
+			if ref, ok := allOf.Search("$ref").Data().(string); ok {
+				refs = append(refs, ref)
+			}
+		}
+
+		require.Equal(t, []string{
+			"#/components/schemas/34-embedded-promotion.Owner",
+			"#/components/schemas/34-embedded-promotion.timestamps",
+		}, refs)
+	})
+
+	t.Run("Adds the promoted fields of embedded structs with hidden fields", func(t *testing.T) {
+		properties := pet.Search("allOf", "2", "properties")
+
+		require.Equal(t, "integer", properties.Search("id", "type").Data().(string))
+		require.Equal(t, "string", properties.Search("name", "type").Data().(string))
+		require.Equal(t, "integer", properties.Search("size", "type").Data().(string))
+		require.Equal(t, "integer", properties.Search("Size", "type").Data().(string))
+		require.False(t, properties.Exists("Pattern"))
+	})
+
+	t.Run("Named and hidden embedded structs", func(t *testing.T) {
+		properties := pet.Search("allOf", "2", "properties")
+
+		require.Equal(t, "#/components/schemas/34-embedded-promotion.Location", properties.Search("location", "$ref").Data().(string))
+		require.False(t, properties.Exists("secret"))
+		require.False(t, properties.Exists("Internal"))
+	})
+
+	t.Run("Embedded types that aren't structs", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/34-embedded-promotion.Colour", pet.Search("allOf", "2", "properties", "Colour", "$ref").Data().(string))
+	})
+}
@@ -0,0 +1,48 @@
+package petstore
+
+type Base struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type timestamps struct {
+	CreatedAt string `json:"createdAt"`
+}
+
+type Owner struct {
+	OwnerName string `json:"ownerName"`
+}
+
+type Location struct {
+	City string `json:"city"`
+}
+
+type Internal struct {
+	Secret string `json:"secret"`
+}
+
+type Colour string
+
+type Markings struct {
+	Pattern string
+	Size    int `json:"size"`
+}
+
+type Spots struct {
+	Pattern string
+	Size    int
+}
+
+// Pet embeds structs whose fields are promoted, hidden or conflict with each other.
+type Pet struct {
+	Base
+	timestamps
+	*Owner
+	Location `json:"location"`
+	Internal `json:"-"`
+	Colour
+	Markings
+	Spots
+
+	Name string `json:"name"`
+}
@@ -0,0 +1,72 @@
+package petstore
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+func indentedJSON(c *gin.Context) {
+	c.IndentedJSON(http.StatusOK, Pet{})
+}
+
+func secureJSON(c *gin.Context) {
+	c.SecureJSON(http.StatusOK, []Pet{})
+}
+
+func pureJSON(c *gin.Context) {
+	c.PureJSON(http.StatusOK, Pet{})
+}
+
+func asciiJSON(c *gin.Context) {
+	c.AsciiJSON(http.StatusOK, Pet{})
+}
+
+func jsonp(c *gin.Context) {
+	if c.Query("status") == "" {
+		c.JSONP(http.StatusBadRequest, gin.H{})
+		return
+	}
+
+	c.JSONP(http.StatusOK, Pet{})
+}
+
+func toml(c *gin.Context) {
+	c.TOML(http.StatusOK, Pet{})
+}
+
+func html(c *gin.Context) {
+	c.HTML(http.StatusOK, "pet.tmpl", Pet{})
+}
+
+func redirect(c *gin.Context) {
+	c.Redirect(http.StatusMovedPermanently, "/pets/new")
+}
+
+func file(c *gin.Context) {
+	c.File("pet.png")
+}
+
+func fileAttachment(c *gin.Context) {
+	c.FileAttachment("pet.png", "pet.png")
+}
+
+func fileFromFS(c *gin.Context) {
+	c.FileFromFS("pet.png", http.Dir("."))
+}
+
+func dataFromReader(c *gin.Context) {
+	reader := strings.NewReader("")
+	c.DataFromReader(http.StatusOK, reader.Size(), "image/png", reader, nil)
+}
+
+func negotiate(c *gin.Context) {
+	c.Negotiate(http.StatusOK, gin.Negotiate{
+		Offered:  []string{binding.MIMEJSON, binding.MIMEXML, binding.MIMEHTML, "application/x-msgpack"},
+		HTMLName: "pet.tmpl",
+		JSONData: PetSummary{},
+		Data:     Pet{},
+	})
+}
@@ -0,0 +1,85 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinResponseHelpers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+	petRef := "#/components/schemas/35-gin-response-helpers.Pet"
+
+	t.Run("JSON variants", func(t *testing.T) {
+		for _, path := range []string{"/indented-json", "/pure-json", "/ascii-json"} {
+			require.Equal(t, petRef, paths.Search(path, "get", "responses", "200", "content", "application/json", "schema", "$ref").Data().(string), path)
+		}
+
+		require.Equal(t, "array", paths.Search("/secure-json", "get", "responses", "200", "content", "application/json", "schema", "type").Data().(string))
+	})
+
+	t.Run("JSONP", func(t *testing.T) {
+		responses := paths.Search("/jsonp", "get", "responses", "200", "content")
+		require.Equal(t, petRef, responses.Search("application/json", "schema", "$ref").Data().(string))
+		require.Equal(t, "string", responses.Search("application/javascript", "schema", "type").Data().(string))
+
+		require.Equal(t, "callback", paths.Search("/jsonp", "get", "parameters", "0", "name").Data().(string))
+		require.Equal(t, "query", paths.Search("/jsonp", "get", "parameters", "0", "in").Data().(string))
+
+		// The callback query param is only added once, for both calls to JSONP
+		var callbacks int
+		for _, parameter := range paths.Search("/jsonp", "get", "parameters").Children() {
+			if parameter.Search("name").Data().(string) == "callback" {
+				callbacks++
+			}
+		}
+		require.Equal(t, 1, callbacks)
+		require.True(t, paths.Exists("/jsonp", "get", "responses", "400", "content", "application/javascript"))
+	})
+
+	t.Run("TOML and HTML", func(t *testing.T) {
+		require.True(t, paths.Exists("/toml", "get", "responses", "200", "content", "application/toml"))
+		require.Equal(t, "string", paths.Search("/html", "get", "responses", "200", "content", "text/html", "schema", "type").Data().(string))
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		response := paths.Search("/redirect", "get", "responses", "301")
+		require.Equal(t, "string", response.Search("headers", "Location", "schema", "type").Data().(string))
+		require.True(t, response.Search("headers", "Location", "required").Data().(bool))
+		require.Empty(t, response.Search("content").ChildrenMap())
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		for _, path := range []string{"/file", "/file-attachment", "/file-from-fs"} {
+			schema := paths.Search(path, "get", "responses", "200", "content", "application/octet-stream", "schema")
+			require.Equal(t, "string", schema.Search("type").Data().(string), path)
+			require.Equal(t, "binary", schema.Search("format").Data().(string), path)
+		}
+
+		require.True(t, paths.Exists("/file-attachment", "get", "responses", "200", "headers", "Content-Disposition"))
+		require.False(t, paths.Exists("/file", "get", "responses", "200", "headers"))
+
+		require.Equal(t, "binary", paths.Search("/data-from-reader", "get", "responses", "200", "content", "image/png", "schema", "format").Data().(string))
+	})
+
+	t.Run("Negotiate", func(t *testing.T) {
+		content := paths.Search("/negotiate", "get", "responses", "200", "content")
+		require.Len(t, content.ChildrenMap(), 3)
+
+		require.Equal(t, "#/components/schemas/35-gin-response-helpers.PetSummary", content.Search("application/json", "schema", "$ref").Data().(string))
+		require.Equal(t, petRef, content.Search("application/xml", "schema", "$ref").Data().(string))
+		require.Equal(t, "string", content.Search("text/html", "schema", "type").Data().(string))
+
+		require.True(t, paths.Exists("/negotiate", "get", "responses", "406"))
+	})
+}
@@ -0,0 +1,23 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/indented-json", indentedJSON)
+	r.GET("/secure-json", secureJSON)
+	r.GET("/pure-json", pureJSON)
+	r.GET("/ascii-json", asciiJSON)
+	r.GET("/jsonp", jsonp)
+	r.GET("/toml", toml)
+	r.GET("/html", html)
+	r.GET("/redirect", redirect)
+	r.GET("/file", file)
+	r.GET("/file-attachment", fileAttachment)
+	r.GET("/file-from-fs", fileFromFS)
+	r.GET("/data-from-reader", dataFromReader)
+	r.GET("/negotiate", negotiate)
+
+	return r
+}
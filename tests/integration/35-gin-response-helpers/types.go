@@ -0,0 +1,9 @@
+package petstore
+
+type Pet struct {
+	Name string `json:"name" xml:"name" toml:"name"`
+}
+
+type PetSummary struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,48 @@
+package petstore
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func notifications(c *gin.Context) {
+	notification := Notification{}
+	c.SSEvent("notification", notification)
+}
+
+func stream(c *gin.Context) {
+	c.Stream(func(w io.Writer) bool {
+		c.SSEvent("notification", Notification{})
+		return true
+	})
+}
+
+func events(c *gin.Context) {
+	c.Stream(func(w io.Writer) bool {
+		c.SSEvent("created", Created{})
+		c.SSEvent("deleted", Deleted{})
+		c.SSEvent("created", Created{})
+		return true
+	})
+}
+
+func untypedStream(c *gin.Context) {
+	c.Stream(func(w io.Writer) bool {
+		_, _ = w.Write([]byte("data: ping\n\n"))
+		return false
+	})
+}
+
+func flushed(c *gin.Context) {
+	c.Header("Content-Type", "text/event-stream")
+	fmt.Fprint(c.Writer, "data: ping\n\n")
+	c.Writer.Flush()
+}
+
+func responseController(c *gin.Context) {
+	fmt.Fprint(c.Writer, "data: ping\n\n")
+	_ = http.NewResponseController(c.Writer).Flush()
+}
@@ -0,0 +1,42 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinStreaming(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	t.Run("Typed events", func(t *testing.T) {
+		for _, path := range []string{"/notifications", "/stream"} {
+			content := paths.Search(path, "get", "responses", "200", "content")
+			require.Len(t, content.ChildrenMap(), 1, path)
+			require.Equal(t, "#/components/schemas/36-gin-streaming.Notification", content.Search("text/event-stream", "schema", "$ref").Data().(string), path)
+		}
+	})
+
+	t.Run("Events of different types", func(t *testing.T) {
+		oneOf := paths.Search("/events", "get", "responses", "200", "content", "text/event-stream", "schema", "oneOf")
+		require.Len(t, oneOf.Children(), 2)
+		require.Equal(t, "#/components/schemas/36-gin-streaming.Created", oneOf.Index(0).Search("$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/36-gin-streaming.Deleted", oneOf.Index(1).Search("$ref").Data().(string))
+	})
+
+	t.Run("Untyped streams", func(t *testing.T) {
+		for _, path := range []string{"/untyped-stream", "/flushed", "/response-controller"} {
+			require.Equal(t, "string", paths.Search(path, "get", "responses", "200", "content", "text/event-stream", "schema", "type").Data().(string), path)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/notifications", notifications)
+	r.GET("/stream", stream)
+	r.GET("/events", events)
+	r.GET("/untyped-stream", untypedStream)
+	r.GET("/flushed", flushed)
+	r.GET("/response-controller", responseController)
+
+	return r
+}
@@ -0,0 +1,15 @@
+package petstore
+
+type Notification struct {
+	ID      int    `json:"id"`
+	Message string `json:"message"`
+}
+
+type Created struct {
+	ID int `json:"id"`
+}
+
+type Deleted struct {
+	ID     int    `json:"id"`
+	Reason string `json:"reason"`
+}
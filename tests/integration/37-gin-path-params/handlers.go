@@ -0,0 +1,46 @@
+package petstore
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func getPet(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, id)
+}
+
+func getPetVaccinated(c *gin.Context) {
+	vaccinatedParam := c.Param("vaccinated")
+	vaccinated, _ := strconv.ParseBool(vaccinatedParam)
+
+	c.JSON(http.StatusOK, vaccinated)
+}
+
+func getPetByUUID(c *gin.Context) {
+	id := uuid.MustParse(c.Param("uuid"))
+
+	c.JSON(http.StatusOK, id.String())
+}
+
+func getOwnerPet(c *gin.Context) {
+	var req PetURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, req.Name)
+}
+
+func getPetTag(c *gin.Context) {
+	c.JSON(http.StatusOK, c.Param("tag"))
+}
@@ -0,0 +1,73 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinPathParams(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	pathParam := func(t *testing.T, path, name string) *gabs.Container {
+		t.Helper()
+
+		for _, parameter := range paths.Search(path, "get", "parameters").Children() {
+			if parameter.Search("name").Data().(string) == name {
+				require.Equal(t, "path", parameter.Search("in").Data().(string))
+				return parameter.Search("schema")
+			}
+		}
+
+		require.Failf(t, "path param not found", "%s %s", path, name)
+		return nil
+	}
+
+	t.Run("Converted with strconv", func(t *testing.T) {
+		schema := pathParam(t, "/pets/{id}", "id")
+		require.Equal(t, "integer", schema.Search("type").Data().(string))
+
+		schema = pathParam(t, "/pets/{id}/vaccinated/{vaccinated}", "vaccinated")
+		require.Equal(t, "boolean", schema.Search("type").Data().(string))
+
+		schema = pathParam(t, "/pets/{id}/vaccinated/{vaccinated}", "id")
+		require.Equal(t, "string", schema.Search("type").Data().(string))
+	})
+
+	t.Run("Converted to a UUID", func(t *testing.T) {
+		schema := pathParam(t, "/pets/uuid/{uuid}", "uuid")
+		require.Equal(t, "string", schema.Search("type").Data().(string))
+		require.Equal(t, "uuid", schema.Search("format").Data().(string))
+	})
+
+	t.Run("Bound with ShouldBindUri", func(t *testing.T) {
+		path := "/owners/{ownerId}/pets/{species}/{name}"
+
+		schema := pathParam(t, path, "ownerId")
+		require.Equal(t, "integer", schema.Search("type").Data().(string))
+		require.Equal(t, 1.0, schema.Search("minimum").Data().(float64))
+
+		schema = pathParam(t, path, "species")
+		require.Equal(t, "#/components/schemas/37-gin-path-params.Species", schema.Search("$ref").Data().(string))
+
+		schema = pathParam(t, path, "name")
+		require.Equal(t, "string", schema.Search("type").Data().(string))
+		require.Equal(t, 20.0, schema.Search("maxLength").Data().(float64))
+	})
+
+	t.Run("Not converted", func(t *testing.T) {
+		schema := pathParam(t, "/tags/{tag}", "tag")
+		require.Equal(t, "string", schema.Search("type").Data().(string))
+	})
+}
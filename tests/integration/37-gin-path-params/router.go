@@ -0,0 +1,15 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets/:id", getPet)
+	r.GET("/pets/:id/vaccinated/:vaccinated", getPetVaccinated)
+	r.GET("/pets/uuid/:uuid", getPetByUUID)
+	r.GET("/owners/:ownerId/pets/:species/:name", getOwnerPet)
+	r.GET("/tags/:tag", getPetTag)
+
+	return r
+}
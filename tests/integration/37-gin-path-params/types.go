@@ -0,0 +1,14 @@
+package petstore
+
+type Species string
+
+const (
+	SpeciesCat Species = "cat"
+	SpeciesDog Species = "dog"
+)
+
+type PetURI struct {
+	OwnerID int     `uri:"ownerId" binding:"required,min=1"`
+	Species Species `uri:"species"`
+	Name    string  `uri:"name" binding:"max=20"`
+}
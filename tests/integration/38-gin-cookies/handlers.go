@@ -0,0 +1,49 @@
+package petstore
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+const sessionCookie = "session"
+
+func login(c *gin.Context) {
+	c.SetCookie(sessionCookie, "token", 3600, "/", "example.com", true, true)
+	c.SetCookie("theme", "dark", 0, "", "", false, false)
+
+	c.Status(http.StatusNoContent)
+}
+
+func logout(c *gin.Context) {
+	session, err := c.Cookie(sessionCookie)
+	if err != nil {
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	secure := os.Getenv("SECURE") == "true"
+	c.SetCookie(sessionCookie, session, -1, "/", "", secure, true)
+
+	c.Status(http.StatusNoContent)
+}
+
+func preferences(c *gin.Context) {
+	themeCookie := "theme"
+	theme, _ := c.Cookie(themeCookie)
+
+	language := cookieOrDefault(c, "language", "en")
+
+	c.String(http.StatusOK, theme+" "+language)
+}
+
+// cookieOrDefault reads a cookie whose name is only known by the caller.
+func cookieOrDefault(c *gin.Context, name string, fallback string) string {
+	value, err := c.Cookie(name)
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
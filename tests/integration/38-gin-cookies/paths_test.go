@@ -0,0 +1,46 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinCookies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	t.Run("Request cookies", func(t *testing.T) {
+		parameter := paths.Search("/logout", "post", "parameters", "0")
+		require.Equal(t, "session", parameter.Search("name").Data().(string))
+		require.Equal(t, "cookie", parameter.Search("in").Data().(string))
+		require.Equal(t, "string", parameter.Search("schema", "type").Data().(string))
+
+		require.False(t, paths.Exists("/login", "post", "parameters"))
+
+		// The name of the theme cookie is followed from its variable, while the name of the language cookie can't be found
+		require.Len(t, paths.Search("/preferences", "get", "parameters").Children(), 1)
+		require.Equal(t, "theme", paths.Search("/preferences", "get", "parameters", "0", "name").Data().(string))
+		require.Equal(t, "cookie", paths.Search("/preferences", "get", "parameters", "0", "in").Data().(string))
+	})
+
+	t.Run("Response cookies", func(t *testing.T) {
+		header := paths.Search("/login", "post", "responses", "204", "headers", "Set-Cookie")
+		require.Equal(t, "string", header.Search("schema", "type").Data().(string))
+		require.Equal(t, "Sets the cookies: `session=<value>; Path=/; Domain=example.com; Max-Age=3600; HttpOnly; Secure`, `theme=<value>; Path=/`", header.Search("description").Data().(string))
+
+		for _, statusCode := range []string{"204", "401"} {
+			header = paths.Search("/logout", "post", "responses", statusCode, "headers", "Set-Cookie")
+			require.Equal(t, "Sets the cookies: `session=<value>; Path=/; Max-Age=0; HttpOnly`", header.Search("description").Data().(string), statusCode)
+		}
+	})
+}
@@ -0,0 +1,13 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.POST("/login", login)
+	r.POST("/logout", logout)
+	r.GET("/preferences", preferences)
+
+	return r
+}
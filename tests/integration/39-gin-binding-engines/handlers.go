@@ -0,0 +1,107 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+func createPetJSON(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindWith(&pet, binding.JSON); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func createPetXML(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindBodyWith(&pet, binding.XML); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func createPetForm(c *gin.Context) {
+	var pet PetDTO
+	if err := c.MustBindWith(&pet, binding.FormMultipart); err != nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func createPetMsgPack(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindWith(&pet, binding.MsgPack); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func createPetTOML(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindTOML(&pet); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func createPetYAML(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindBodyWithYAML(&pet); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func listPets(c *gin.Context) {
+	var query PetQuery
+	if err := c.ShouldBindWith(&query, binding.Query); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, []PetDTO{})
+}
+
+func getPet(c *gin.Context) {
+	var headers PetHeaders
+	if err := c.ShouldBindWith(&headers, binding.Header); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, PetDTO{})
+}
+
+type customBinding struct{}
+
+func (customBinding) Name() string {
+	return "custom"
+}
+
+func (customBinding) Bind(*http.Request, any) error {
+	return nil
+}
+
+func createPetCustom(c *gin.Context) {
+	var pet PetDTO
+	if err := c.ShouldBindWith(&pet, customBinding{}); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
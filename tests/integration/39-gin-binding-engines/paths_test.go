@@ -0,0 +1,54 @@
+package petstore
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinBindingEngines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	paths := testAstra.Path("paths")
+
+	t.Run("Body bindings", func(t *testing.T) {
+		bodies := map[string]string{
+			"/pets/json":    "application/json",
+			"/pets/xml":     "application/xml",
+			"/pets/form":    "multipart/form-data",
+			"/pets/msgpack": "application/x-msgpack",
+			"/pets/toml":    "application/toml",
+			"/pets/yaml":    "application/yaml",
+		}
+
+		for path, contentType := range bodies {
+			content := paths.Search(path, "post", "requestBody", "content")
+			require.Len(t, content.ChildrenMap(), 1, path)
+			require.True(t, content.Exists(contentType, "schema", "$ref"), path)
+		}
+	})
+
+	t.Run("Query binding", func(t *testing.T) {
+		parameter := paths.Search("/pets", "get", "parameters", "0")
+		require.Equal(t, "limit", parameter.Search("name").Data().(string))
+		require.Equal(t, "query", parameter.Search("in").Data().(string))
+	})
+
+	t.Run("Header binding", func(t *testing.T) {
+		parameter := paths.Search("/pets/{id}", "get", "parameters", "0")
+		require.Equal(t, "header", parameter.Search("in").Data().(string))
+		require.Equal(t, "#/components/schemas/39-gin-binding-engines.PetHeaders", parameter.Search("schema", "$ref").Data().(string))
+	})
+
+	t.Run("Custom binding", func(t *testing.T) {
+		require.False(t, paths.Exists("/pets/custom", "post", "requestBody"))
+	})
+}
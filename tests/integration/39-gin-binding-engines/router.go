@@ -0,0 +1,19 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.POST("/pets/json", createPetJSON)
+	r.POST("/pets/xml", createPetXML)
+	r.POST("/pets/form", createPetForm)
+	r.POST("/pets/msgpack", createPetMsgPack)
+	r.POST("/pets/toml", createPetTOML)
+	r.POST("/pets/yaml", createPetYAML)
+	r.POST("/pets/custom", createPetCustom)
+	r.GET("/pets", listPets)
+	r.GET("/pets/:id", getPet)
+
+	return r
+}
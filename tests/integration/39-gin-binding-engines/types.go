@@ -0,0 +1,14 @@
+package petstore
+
+type PetDTO struct {
+	Name string `json:"name" xml:"name" yaml:"name" toml:"name" form:"name"`
+	Age  int    `json:"age" xml:"age" yaml:"age" toml:"age" form:"age"`
+}
+
+type PetQuery struct {
+	Limit int `form:"limit"`
+}
+
+type PetHeaders struct {
+	RequestID string `header:"X-Request-ID"`
+}
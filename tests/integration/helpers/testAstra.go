@@ -26,7 +26,13 @@ func SetupTestAstraWithDefaultConfig(t *testing.T, r *gin.Engine, options ...ast
 func SetupTestAstra(t *testing.T, r *gin.Engine, config *astra.Config, options ...astra.Option) (*gabs.Container, error) {
 	t.Helper()
 
-	options = append(options, inputs.WithGinInput(r), outputs.WithOpenAPIOutput("./output.json"))
+	return SetupTestAstraWithInput(t, inputs.WithGinInput(r), config, options...)
+}
+
+func SetupTestAstraWithInput(t *testing.T, input astra.Option, config *astra.Config, options ...astra.Option) (*gabs.Container, error) {
+	t.Helper()
+
+	options = append(options, input, outputs.WithOpenAPIOutput("./output.json"))
 
 	gen := astra.New(options...)
 
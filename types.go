@@ -16,10 +16,14 @@ type Route struct {
 	Body        []BodyParam  `json:"body,omitempty" yaml:"body,omitempty"`
 	ReturnTypes []ReturnType `json:"returnTypes,omitempty" yaml:"returnTypes,omitempty"`
 	Doc         string       `json:"doc,omitempty" yaml:"doc,omitempty"`
+	Deprecated  bool         `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 	OperationID string       `json:"operationId,omitempty" yaml:"operationId,omitempty"`
 
 	RequestHeaders  []Param `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
 	ResponseHeaders []Param `json:"responseHeaders,omitempty" yaml:"responseHeaders,omitempty"`
+
+	RequestCookies  []Param  `json:"requestCookies,omitempty" yaml:"requestCookies,omitempty"`
+	ResponseCookies []Cookie `json:"responseCookies,omitempty" yaml:"responseCookies,omitempty"`
 }
 
 // ReturnType is a return type for a route.
@@ -28,6 +32,21 @@ type ReturnType struct {
 	StatusCode  int    `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
 	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
 	Field       Field  `json:"field,omitempty" yaml:"field,omitempty"`
+	// Headers are the headers that are only sent with this response (i.e. the Location header of a redirect)
+	Headers []Param `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// IsStream is whether the response is streamed (i.e. Server-Sent Events), where the field is the type of each event
+	IsStream bool `json:"isStream,omitempty" yaml:"isStream,omitempty"`
+}
+
+// Cookie is a cookie that is set by a route.
+// Its attributes are only known if they are constants where the cookie is set.
+type Cookie struct {
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	Path     string `json:"path,omitempty" yaml:"path,omitempty"`
+	Domain   string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	MaxAge   int    `json:"maxAge,omitempty" yaml:"maxAge,omitempty"` // A negative MaxAge deletes the cookie, as in http.Cookie.
+	Secure   bool   `json:"secure,omitempty" yaml:"secure,omitempty"`
+	HTTPOnly bool   `json:"httpOnly,omitempty" yaml:"httpOnly,omitempty"`
 }
 
 // Param is a parameter for a route.
@@ -72,10 +91,12 @@ type Field struct {
 	Type    string `json:"type,omitempty" yaml:"type,omitempty"`
 	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
 
-	EnumValues []any `json:"enumValues,omitempty" yaml:"enumValues,omitempty"`
+	EnumValues []any    `json:"enumValues,omitempty" yaml:"enumValues,omitempty"`
+	EnumNames  []string `json:"enumNames,omitempty" yaml:"enumNames,omitempty"`
 
 	IsRequired bool `json:"isRequired,omitempty" yaml:"isRequired,omitempty"`
 	IsEmbedded bool `json:"isEmbedded,omitempty" yaml:"isEmbedded,omitempty"`
+	IsPointer  bool `json:"isPointer,omitempty" yaml:"isPointer,omitempty"`
 
 	SliceType string `json:"sliceType,omitempty" yaml:"sliceType,omitempty"`
 
@@ -86,9 +107,20 @@ type Field struct {
 	MapKeyType    string `json:"mapKeyType,omitempty" yaml:"mapKeyType,omitempty"`
 	MapValueType  string `json:"mapValueType,omitempty" yaml:"mapValueType,omitempty"`
 
+	TypeArgs []Field `json:"typeArgs,omitempty" yaml:"typeArgs,omitempty"`
+
+	Marshaler astTraversal.MarshalerType `json:"marshaler,omitempty" yaml:"marshaler,omitempty"`
+
+	Implementations    []Field `json:"implementations,omitempty" yaml:"implementations,omitempty"`
+	Discriminator      string  `json:"discriminator,omitempty" yaml:"discriminator,omitempty"`
+	DiscriminatorValue string  `json:"discriminatorValue,omitempty" yaml:"discriminatorValue,omitempty"`
+
 	StructFields              map[string]Field              `json:"structFields,omitempty" yaml:"structFields,omitempty"`
 	StructFieldBindingTags    astTraversal.BindingTagMap    `json:"structFieldBindingTags,omitempty" yaml:"structFieldBindingTags,omitempty"`
 	StructFieldValidationTags astTraversal.ValidationTagMap `json:"structFieldValidationTags,omitempty" yaml:"structFieldValidationTags,omitempty"`
 
-	Doc string `json:"doc,omitempty" yaml:"doc,omitempty"`
+	Doc        string   `json:"doc,omitempty" yaml:"doc,omitempty"`
+	Deprecated bool     `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Examples   []string `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Default    string   `json:"default,omitempty" yaml:"default,omitempty"`
 }
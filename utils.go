@@ -12,12 +12,17 @@ func ParseResultToField(result astTraversal.Result) Field {
 		Type:                      result.Type,
 		Name:                      result.Name,
 		EnumValues:                result.EnumValues,
+		EnumNames:                 result.EnumNames,
 		IsEmbedded:                result.IsEmbedded,
+		IsPointer:                 result.IsPointer,
 		SliceType:                 result.SliceType,
 		ArrayType:                 result.ArrayType,
 		ArrayLength:               result.ArrayLength,
 		MapKeyType:                result.MapKeyType,
 		MapValueType:              result.MapValueType,
+		Marshaler:                 result.Marshaler,
+		Discriminator:             result.Discriminator,
+		DiscriminatorValue:        result.DiscriminatorValue,
 		StructFieldBindingTags:    result.StructFieldBindingTags,
 		StructFieldValidationTags: result.StructFieldValidationTags,
 	}
@@ -26,6 +31,9 @@ func ParseResultToField(result astTraversal.Result) Field {
 	if result.Doc != "" {
 		field.Doc = strings.TrimSpace(result.Doc)
 	}
+	field.Deprecated = result.DocMetadata.Deprecated
+	field.Examples = result.DocMetadata.Examples
+	field.Default = result.DocMetadata.Default
 
 	// If the type is not a primitive type, we need to get the package path.
 	// If the type is named, it is referring to a type.
@@ -44,6 +52,22 @@ func ParseResultToField(result astTraversal.Result) Field {
 		field.MapKeyPackage = result.MapKeyPackage.Path()
 	}
 
+	// If the type arguments are populated (for an instantiated generic type), we need to parse them.
+	if result.TypeArgs != nil {
+		field.TypeArgs = make([]Field, 0, len(result.TypeArgs))
+		for _, typeArg := range result.TypeArgs {
+			field.TypeArgs = append(field.TypeArgs, ParseResultToField(typeArg))
+		}
+	}
+
+	// If the implementations are populated (for a named interface), we need to parse them.
+	if result.Implementations != nil {
+		field.Implementations = make([]Field, 0, len(result.Implementations))
+		for _, implementation := range result.Implementations {
+			field.Implementations = append(field.Implementations, ParseResultToField(implementation))
+		}
+	}
+
 	// If the struct fields are populated, we need to parse them.
 	if result.StructFields != nil {
 		field.StructFields = make(map[string]Field)
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+)
+
+// Closures finds the function literals declared directly within a function declaration or function literal, in source order.
+// Function literals nested inside other function literals are not included, which matches how the compiler numbers closures (func1, func2, etc.).
+func Closures(node ast.Node) []*ast.FuncLit {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	}
+	if body == nil {
+		return nil
+	}
+
+	closures := make([]*ast.FuncLit, 0)
+	ast.Inspect(body, func(n ast.Node) bool {
+		funcLit, ok := n.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+
+		closures = append(closures, funcLit)
+
+		return false
+	})
+
+	return closures
+}
+
+// ClosureName creates the runtime name of a function literal declared within the function declaration (i.e. main.setupRouter.func1).
+// The name of the function declaration is returned if the function literal isn't declared directly within it.
+func ClosureName(pkgPath string, funcDecl *ast.FuncDecl, funcLit *ast.FuncLit) string {
+	name := pkgPath + "." + funcDecl.Name.Name
+
+	index := slices.Index(Closures(funcDecl), funcLit)
+	if index == -1 {
+		return name
+	}
+
+	return fmt.Sprintf("%s.func%d", name, index+1)
+}
+
+// MethodValueName creates the runtime name of a method value (i.e. main.(*Handler).getPets-fm for h.getPets).
+// The compiler wraps the method in its own function, which is named after the type of the receiver that the method is declared with.
+func MethodValueName(pkgPath string, funcType *types.Func) string {
+	recv := funcType.Type().(*types.Signature).Recv().Type()
+
+	format := "%s.%s.%s" + methodValueSuffix
+	if pointer, ok := recv.(*types.Pointer); ok {
+		recv = pointer.Elem()
+		format = "%s.(*%s).%s" + methodValueSuffix
+	}
+
+	var recvName string
+	if named, ok := recv.(*types.Named); ok {
+		recvName = named.Obj().Name()
+	}
+
+	return fmt.Sprintf(format, pkgPath, recvName, funcType.Name())
+}
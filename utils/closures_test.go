@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const closuresSource = `package main
+
+func setupRouter() {
+	first := func() {
+		nested := func() {}
+		nested()
+	}
+	first()
+
+	go func() {}()
+}
+`
+
+func TestClosures(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", closuresSource, 0)
+	require.NoError(t, err)
+
+	funcDecl := file.Decls[0].(*ast.FuncDecl)
+
+	closures := Closures(funcDecl)
+	require.Len(t, closures, 2)
+
+	// The closures nested within a closure are numbered from that closure
+	nested := Closures(closures[0])
+	require.Len(t, nested, 1)
+	require.Empty(t, Closures(nested[0]))
+
+	require.Nil(t, Closures(&ast.Ident{Name: "setupRouter"}))
+}
+
+func TestClosureName(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "main.go", closuresSource, 0)
+	require.NoError(t, err)
+
+	funcDecl := file.Decls[0].(*ast.FuncDecl)
+	closures := Closures(funcDecl)
+
+	require.Equal(t, "main.setupRouter.func1", ClosureName("main", funcDecl, closures[0]))
+	require.Equal(t, "main.setupRouter.func2", ClosureName("main", funcDecl, closures[1]))
+
+	// A closure that is nested within a closure isn't declared directly within the function declaration
+	require.Equal(t, "main.setupRouter", ClosureName("main", funcDecl, Closures(closures[0])[0]))
+}
+
+func TestMethodValueName(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "main.go", `package main
+
+type Handler struct{}
+
+func (h *Handler) List() {}
+
+func (h Handler) Count() {}
+`, 0)
+	require.NoError(t, err)
+
+	pkg, err := new(types.Config).Check("main", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	handler := pkg.Scope().Lookup("Handler").Type().(*types.Named)
+	methods := make(map[string]*types.Func)
+	for i := 0; i < handler.NumMethods(); i++ {
+		methods[handler.Method(i).Name()] = handler.Method(i)
+	}
+
+	require.Equal(t, "main.(*Handler).List-fm", MethodValueName("main", methods["List"]))
+	require.Equal(t, "main.Handler.Count-fm", MethodValueName("main", methods["Count"]))
+}
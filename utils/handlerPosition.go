@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// HandlerLocator finds the file and line number of handler functions from their fully qualified names.
+// It is used when the runtime can't provide the position of the handler, i.e. echo only keeps the name of the handler once the route is registered,
+// and a method value (e.g. main.(*Handler).List-fm) is wrapped in a function that the compiler generates.
+// Each package is only loaded once, so it is shared by all the handlers in the package.
+type HandlerLocator struct {
+	workDir  string
+	fset     *token.FileSet
+	packages map[string][]*packages.Package
+}
+
+// NewHandlerLocator creates a handler locator that loads the packages of the handlers from the working directory.
+func NewHandlerLocator(workDir string) *HandlerLocator {
+	return &HandlerLocator{
+		workDir:  workDir,
+		fset:     token.NewFileSet(),
+		packages: make(map[string][]*packages.Package),
+	}
+}
+
+// Find finds the file and line number of a handler function from its fully qualified name.
+// We load the syntax of the handler's package and locate the function declaration, following any closures (e.g. main.setupRouter.func1.2).
+// A method value is located by the type of its receiver and the name of the method.
+func (l *HandlerLocator) Find(handlerName string) (string, int, error) {
+	handler := SplitHandlerPath(handlerName)
+	if len(handler.HandlerParts) < 1 {
+		return "", 0, fmt.Errorf("invalid handler name: %s", handlerName)
+	}
+
+	pkgs, err := l.load(handler.PackagePath())
+	if err != nil {
+		return "", 0, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+				if !ok || !handler.IsFuncDecl(funcDecl) {
+					continue
+				}
+
+				var node ast.Node = funcDecl
+				for _, closurePart := range handler.ClosureParts() {
+					// Closures are named func1, func2, etc. and any closures nested within them are named 1, 2, etc.
+					index, err := strconv.Atoi(strings.TrimPrefix(closurePart, "func"))
+					if err != nil {
+						return "", 0, fmt.Errorf("unsupported handler name: %s", handlerName)
+					}
+
+					closures := Closures(node)
+					if index < 1 || index > len(closures) {
+						return "", 0, fmt.Errorf("could not find closure %s for handler: %s", closurePart, handlerName)
+					}
+					node = closures[index-1]
+				}
+
+				pos := l.fset.Position(node.Pos())
+				return pos.Filename, pos.Line, nil
+			}
+		}
+	}
+
+	return "", 0, fmt.Errorf("could not find handler: %s", handlerName)
+}
+
+// load loads the syntax of the package, or returns it if it has already been loaded.
+func (l *HandlerLocator) load(pkgPath string) ([]*packages.Package, error) {
+	if pkgs, ok := l.packages[pkgPath]; ok {
+		return pkgs, nil
+	}
+
+	// The main package can't be imported, so we load it from the working directory instead.
+	pattern := pkgPath
+	if pattern == "main" {
+		pattern = "."
+	}
+
+	// Only the syntax is required, so we avoid type checking the package and its dependencies here.
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+		Dir:  l.workDir,
+		Fset: l.fset,
+	}, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	l.packages[pkgPath] = pkgs
+
+	return pkgs, nil
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerLocator_Find(t *testing.T) {
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "go.mod"), []byte("module example.com/handlers\n\ngo 1.22\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "main.go"), []byte(`package main
+
+type handler struct{}
+
+func (h *handler) list() {}
+
+type other struct{}
+
+func (o other) list() {}
+
+func getPets() {}
+
+func setup() {
+	_ = func() {
+		_ = func() {}
+	}
+}
+
+func main() {}
+`), 0644))
+
+	locator := NewHandlerLocator(workDir)
+
+	testCases := []struct {
+		name    string
+		handler string
+		line    int
+	}{
+		{name: "function", handler: "main.getPets", line: 11},
+		{name: "pointer method value", handler: "main.(*handler).list-fm", line: 5},
+		{name: "value method value", handler: "main.other.list-fm", line: 9},
+		{name: "nested closure", handler: "main.setup.func1.1", line: 15},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			file, line, err := locator.Find(testCase.handler)
+			require.NoError(t, err)
+			require.Equal(t, "main.go", filepath.Base(file))
+			require.Equal(t, testCase.line, line)
+		})
+	}
+
+	t.Run("loads each package once", func(t *testing.T) {
+		require.Len(t, locator.packages, 1)
+	})
+
+	t.Run("doesn't find a missing handler", func(t *testing.T) {
+		_, _, err := locator.Find("main.(*handler).create-fm")
+		require.Error(t, err)
+
+		_, _, err = locator.Find("create-user")
+		require.Error(t, err)
+	})
+}
@@ -2,15 +2,53 @@ package utils
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/ls6-events/astra"
 )
 
 // getPathParamRegex returns a regex to match path parameters.
-// This regex matches both :param and *param (gin style).
+// This regex matches both :param and *param (gin style), {param} and {param:regex} (chi style), {param...} (net/http style), as well as :param? and :param<constraint> (fiber style).
 // It will need to be updated if other frameworks are supported that contain different syntax.
 func getPathParamRegex() *regexp.Regexp {
-	return regexp.MustCompile(`:[^\/]+|\*[^\/]+`)
+	return regexp.MustCompile(`:[^\/]+|\*[^\/]+|\{[^\/]+\}`)
+}
+
+// ParsePathParam parses a path parameter matched by the path parameter regex.
+// It returns the name of the parameter and whether it is required.
+func ParsePathParam(param string) (name string, isRequired bool) {
+	switch param[0] {
+	case ':':
+		name = param[1:]
+
+		// A constraint can be specified after the name of the parameter (i.e. :id<int>).
+		if index := strings.Index(name, "<"); index != -1 {
+			name = name[:index]
+		}
+
+		// An optional parameter is suffixed with a question mark (i.e. :id?).
+		if strings.HasSuffix(name, "?") {
+			return strings.TrimSuffix(name, "?"), false
+		}
+
+		return name, true
+	case '*':
+		return param[1:], false
+	}
+
+	name = strings.TrimSuffix(strings.TrimPrefix(param, "{"), "}")
+
+	// A wildcard parameter matches the remainder of the path (i.e. {path...}), which can be empty.
+	if strings.HasSuffix(name, "...") {
+		return strings.TrimSuffix(name, "..."), false
+	}
+
+	// A regex can be specified after the name of the parameter (i.e. {id:[0-9]+}).
+	if index := strings.Index(name, ":"); index != -1 {
+		name = name[:index]
+	}
+
+	return name, true
 }
 
 // ExtractParamsFromPath extracts the parameters from a path.
@@ -21,12 +59,13 @@ func ExtractParamsFromPath(path string) []astra.Param {
 	if paramRegex.MatchString(path) {
 		params := paramRegex.FindAllString(path, -1)
 		for _, param := range params {
+			name, isRequired := ParsePathParam(param)
 			resultParams = append(resultParams, astra.Param{
-				Name: param[1:],
+				Name: name,
 				Field: astra.Field{
 					Type: "string",
 				},
-				IsRequired: param[0] == ':',
+				IsRequired: isRequired,
 			})
 		}
 	}
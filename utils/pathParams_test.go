@@ -165,6 +165,66 @@ func TestExtractParamsFromPath(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with chi style params",
+			path: "/pets/{id}/owner/{ownerID:[0-9]+}",
+			result: []astra.Param{
+				{
+					Name: "id",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: true,
+				},
+				{
+					Name: "ownerID",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: true,
+				},
+			},
+		},
+		{
+			name: "with net/http style params",
+			path: "/files/{owner}/{path...}",
+			result: []astra.Param{
+				{
+					Name: "owner",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: true,
+				},
+				{
+					Name: "path",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: false,
+				},
+			},
+		},
+		{
+			name: "with fiber style params",
+			path: "/pets/:id<int>/owner/:ownerID?",
+			result: []astra.Param{
+				{
+					Name: "id",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: true,
+				},
+				{
+					Name: "ownerID",
+					Field: astra.Field{
+						Type: "string",
+					},
+					IsRequired: false,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -174,3 +234,45 @@ func TestExtractParamsFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestMapPathParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		path   string
+		result string
+	}{
+		{
+			name:   "gin style params",
+			path:   "/pets/:id/*path",
+			result: "/pets/{id}/{path*}",
+		},
+		{
+			name:   "chi style params",
+			path:   "/pets/{id}/owner/{ownerID:[0-9]+}",
+			result: "/pets/{id}/owner/{ownerID}",
+		},
+		{
+			name:   "net/http style params",
+			path:   "/files/{owner}/{path...}",
+			result: "/files/{owner}/{path*}",
+		},
+		{
+			name:   "fiber style params",
+			path:   "/pets/:id<int>/owner/:ownerID?",
+			result: "/pets/{id}/owner/{ownerID*}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := MapPathParams(tc.path, func(param string) string {
+				name, isRequired := ParsePathParam(param)
+				if isRequired {
+					return "{" + name + "}"
+				}
+				return "{" + name + "*}"
+			})
+			require.Equal(t, tc.result, result)
+		})
+	}
+}
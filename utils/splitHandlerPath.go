@@ -1,6 +1,12 @@
 package utils
 
-import "strings"
+import (
+	"go/ast"
+	"slices"
+	"strings"
+
+	"github.com/ls6-events/astra"
+)
 
 type HandlerPath struct {
 	PathParts    []string
@@ -39,6 +45,83 @@ func (h HandlerPath) Handler() string {
 	return strings.Join(h.HandlerParts, ".")
 }
 
+// FuncName returns the name of the function of the handler, which is the name of the method for a method value (i.e. List for pkg.(*Handler).List-fm).
 func (h HandlerPath) FuncName() string {
+	if h.Receiver() != "" {
+		return strings.TrimSuffix(h.HandlerParts[1], methodValueSuffix)
+	}
+
 	return h.HandlerParts[0]
 }
+
+// methodValueSuffix is the suffix of the name of a method value (i.e. h.List), which the compiler wraps in its own function.
+const methodValueSuffix = "-fm"
+
+// Receiver returns the type of the receiver for a handler that is a method value (i.e. Handler for pkg.(*Handler).List-fm or pkg.Handler.List-fm).
+// It is empty if the handler is a function.
+func (h HandlerPath) Receiver() string {
+	if len(h.HandlerParts) < 2 {
+		return ""
+	}
+
+	if strings.HasPrefix(h.HandlerParts[0], "(") {
+		return strings.TrimSuffix(strings.TrimPrefix(h.HandlerParts[0], "(*"), ")")
+	}
+
+	if strings.HasSuffix(h.HandlerParts[1], methodValueSuffix) {
+		return h.HandlerParts[0]
+	}
+
+	return ""
+}
+
+// ClosureParts returns the parts of the handler name that are the closures within the function (i.e. func1 and 2 for pkg.setupRouter.func1.2).
+func (h HandlerPath) ClosureParts() []string {
+	if h.Receiver() != "" {
+		return h.HandlerParts[2:]
+	}
+
+	return h.HandlerParts[1:]
+}
+
+// IsFuncDecl checks whether the function declaration is the function of the handler, matching a method by the type of its receiver and its name.
+func (h HandlerPath) IsFuncDecl(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Name.Name != h.FuncName() {
+		return false
+	}
+
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return h.Receiver() == ""
+	}
+
+	return receiverTypeName(funcDecl.Recv.List[0].Type) == h.Receiver()
+}
+
+// receiverTypeName finds the name of the type of a method receiver, without the pointer or type parameters (i.e. Handler for *Handler[T]).
+func receiverTypeName(expr ast.Expr) string {
+	switch n := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(n.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(n.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(n.X)
+	case *ast.Ident:
+		return n.Name
+	}
+
+	return ""
+}
+
+// HandlerPackagePaths returns the unique package paths of the handlers for the routes, in the order they first appear.
+func HandlerPackagePaths(routes []astra.Route) []string {
+	pkgPaths := make([]string, 0)
+	for _, route := range routes {
+		pkgPath := SplitHandlerPath(route.Handler).PackagePath()
+		if !slices.Contains(pkgPaths, pkgPath) {
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+	}
+
+	return pkgPaths
+}
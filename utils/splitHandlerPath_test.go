@@ -1,6 +1,11 @@
 package utils
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/ls6-events/astra"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -61,3 +66,66 @@ func TestSplitHandlerPath(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerPathMethods(t *testing.T) {
+	testCases := []struct {
+		name         string
+		path         string
+		funcName     string
+		receiver     string
+		closureParts []string
+	}{
+		{name: "function", path: "foo/bar.hello", funcName: "hello", receiver: "", closureParts: []string{}},
+		{name: "closure", path: "foo/bar.hello.func1.2", funcName: "hello", receiver: "", closureParts: []string{"func1", "2"}},
+		{name: "pointer method value", path: "foo/bar.(*Handler).List-fm", funcName: "List", receiver: "Handler", closureParts: []string{}},
+		{name: "method value", path: "foo/bar.Handler.List-fm", funcName: "List", receiver: "Handler", closureParts: []string{}},
+		{name: "closure in method", path: "foo/bar.(*Handler).List.func1", funcName: "List", receiver: "Handler", closureParts: []string{"func1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := SplitHandlerPath(tc.path)
+			require.Equal(t, tc.funcName, handler.FuncName())
+			require.Equal(t, tc.receiver, handler.Receiver())
+			require.Equal(t, tc.closureParts, handler.ClosureParts())
+		})
+	}
+}
+
+func TestHandlerPathIsFuncDecl(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "handlers.go", `package bar
+
+type Handler struct{}
+
+func List() {}
+
+func (h *Handler) List() {}
+
+func (h Handler) Get() {}
+`, 0)
+	require.NoError(t, err)
+
+	list := file.Decls[1].(*ast.FuncDecl)
+	method := file.Decls[2].(*ast.FuncDecl)
+	valueMethod := file.Decls[3].(*ast.FuncDecl)
+
+	require.True(t, SplitHandlerPath("foo/bar.List").IsFuncDecl(list))
+	require.False(t, SplitHandlerPath("foo/bar.List").IsFuncDecl(method))
+
+	require.True(t, SplitHandlerPath("foo/bar.(*Handler).List-fm").IsFuncDecl(method))
+	require.False(t, SplitHandlerPath("foo/bar.(*Handler).List-fm").IsFuncDecl(list))
+
+	require.True(t, SplitHandlerPath("foo/bar.Handler.Get-fm").IsFuncDecl(valueMethod))
+	require.False(t, SplitHandlerPath("foo/bar.(*Other).Get-fm").IsFuncDecl(valueMethod))
+}
+
+func TestHandlerPackagePaths(t *testing.T) {
+	routes := []astra.Route{
+		{Handler: "foo/bar.hello"},
+		{Handler: "foo/baz.world.func1"},
+		{Handler: "foo/bar.world"},
+		{Handler: "main.hello"},
+	}
+
+	require.Equal(t, []string{"foo/bar", "foo/baz", "main"}, HandlerPackagePaths(routes))
+}
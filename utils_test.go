@@ -31,6 +31,52 @@ func TestParseResultToField(t *testing.T) {
 		require.Equal(t, "This is a test", field.Doc)
 	})
 
+	t.Run("parses the doc metadata", func(t *testing.T) {
+		result := astTraversal.Result{
+			Type: "string",
+			Doc:  "Name is the name.",
+			DocMetadata: astTraversal.DocMetadata{
+				Deprecated: true,
+				Examples:   []string{"Sam"},
+				Default:    "Alex",
+			},
+		}
+
+		field := ParseResultToField(result)
+
+		require.True(t, field.Deprecated)
+		require.Equal(t, []string{"Sam"}, field.Examples)
+		require.Equal(t, "Alex", field.Default)
+	})
+
+	t.Run("parses the type arguments", func(t *testing.T) {
+		result := astTraversal.Result{
+			Type: "PageString",
+			TypeArgs: []astTraversal.Result{
+				{Type: "string"},
+			},
+		}
+
+		field := ParseResultToField(result)
+
+		require.Equal(t, []Field{{Type: "string"}}, field.TypeArgs)
+	})
+
+	t.Run("parses the implementations", func(t *testing.T) {
+		result := astTraversal.Result{
+			Type:          "any",
+			Discriminator: "kind",
+			Implementations: []astTraversal.Result{
+				{Type: "Circle", DiscriminatorValue: "circle"},
+			},
+		}
+
+		field := ParseResultToField(result)
+
+		require.Equal(t, "kind", field.Discriminator)
+		require.Equal(t, []Field{{Type: "Circle", DiscriminatorValue: "circle"}}, field.Implementations)
+	})
+
 	t.Run("gets the package path for non-primitive types", func(t *testing.T) {
 		makePackage := func(bottomName string) *astTraversal.PackageNode {
 			return &astTraversal.PackageNode{